@@ -0,0 +1,417 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ActivityStreamOptions is an interface for the lookup of Activity Stream
+type ActivityStreamOptions struct {
+	onlyActiveCourses bool
+}
+
+// ActivityStreamOption is an adapter for generating options
+type ActivityStreamOption func(*ActivityStreamOptions)
+
+// ActivityStream is Users activity feed
+type ActivityStream struct {
+	DiscussionTopics   []DiscussionTopic
+	Announcements      []Announcement
+	Conversations      []Conversation
+	Messages           []Message
+	Submissions        []SubmissionStreamItem
+	Conferences        []Conference
+	Collaborations     []Collaboration
+	AssessmentRequests []AssessmentRequest
+	// Unknown holds the items of types this client does not know about
+	Unknown []RawStreamItem
+}
+
+// RawStreamItem is an ActivityStream item of an unknown type kept as it was received
+type RawStreamItem struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// Message is a ActivityStream message
+type Message struct {
+	ID                   int64  `json:"message_id"`
+	StreamItemID         int64  `json:"id"`
+	NotificationCategory string `json:"notification_category"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// DiscussionTopic is a ActivityStream discussion
+type DiscussionTopic struct {
+	ID                         int64 `json:"discussion_topic_id"`
+	StreamItemID               int64 `json:"id"`
+	TotalRootDiscussionEntries int64 `json:"total_root_discussion_entries"`
+	RequireInitialPost         bool  `json:"require_initial_post"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+
+	UserHasPosted         interface{}       `json:"user_has_posted"`
+	RootDiscussionEntries []DiscussionEntry `json:"root_discussion_entries"`
+}
+
+// Announcement is a ActivityStream announcement
+type Announcement struct {
+	ID                         int64             `json:"announcement_id"`
+	StreamItemID               int64             `json:"id"`
+	TotalRootDiscussionEntries int64             `json:"total_root_discussion_entries"`
+	ContextType                string            `json:"context_type"`
+	RequireInitialPost         bool              `json:"require_initial_post"`
+	CreatedAt                  string            `json:"created_at"`
+	UpdatedAt                  string            `json:"updated_at"`
+	Title                      string            `json:"title"`
+	Message                    string            `json:"message"`
+	ReadState                  bool              `json:"read_state"`
+	CourseID                   int64             `json:"course_id"`
+	GroupID                    int64             `json:"group_id"`
+	HTMLURL                    string            `json:"html_url"`
+	UserHasPosted              interface{}       `json:"user_has_posted"`
+	RootDiscussionEntries      []DiscussionEntry `json:"root_discussion_entries"`
+}
+
+// Conversation is an ActivityStream conversation
+type Conversation struct {
+	ID               int64 `json:"conversation_id"`
+	StreamItemID     int64 `json:"id"`
+	Private          bool  `json:"private"`
+	ParticipantCount int64 `json:"participant_count"`
+
+	CreatedAt      string                `json:"created_at"`
+	UpdatedAt      string                `json:"updated_at"`
+	Title          string                `json:"title"`
+	LatestMessages []ConversationMessage `json:"latest_messages"`
+	ReadState      bool                  `json:"read_state"`
+	ContextType    string                `json:"context_type"`
+	CourseID       int64                 `json:"course_id"`
+	GroupID        int64                 `json:"group_id"`
+	HTMLURL        string                `json:"html_url"`
+}
+
+// SubmissionStreamItem is an ActivityStream submission
+type SubmissionStreamItem struct {
+	ID            int64   `json:"submission_id"`
+	StreamItemID  int64   `json:"id"`
+	AssignmentID  int64   `json:"assignment_id"`
+	UserID        int64   `json:"user_id"`
+	Attempt       int64   `json:"attempt"`
+	Grade         string  `json:"grade"`
+	Score         float64 `json:"score"`
+	SubmittedAt   string  `json:"submitted_at"`
+	GradedAt      string  `json:"graded_at"`
+	WorkflowState string  `json:"workflow_state"`
+	Late          bool    `json:"late"`
+	Missing       bool    `json:"missing"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+
+	Assignment *Assignment `json:"assignment"`
+}
+
+//Conference is an ActivityStream conference
+type Conference struct {
+	ID           int64 `json:"web_conference_id"`
+	StreamItemID int64 `json:"id"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// Collaboration is an ActivityStream collaboration
+type Collaboration struct {
+	ID           int64 `json:"collaboration_id"`
+	StreamItemID int64 `json:"id"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// AssessmentRequest is an ActivityStream assessment request
+type AssessmentRequest struct {
+	ID           int64 `json:"assessment_request_id"`
+	StreamItemID int64 `json:"id"`
+
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Title       string `json:"title"`
+	Message     string `json:"message"`
+	ReadState   bool   `json:"read_state"`
+	ContextType string `json:"context_type"`
+	CourseID    int64  `json:"course_id"`
+	GroupID     int64  `json:"group_id"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// streamItemType is used to peek at the type of an ActivityStream item before decoding it
+type streamItemType struct {
+	Type string `json:"type"`
+}
+
+// UnmarshalJSON decodes the list of stream items, sorting each item by its type
+func (s *ActivityStream) UnmarshalJSON(data []byte) error {
+	items := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	stream := ActivityStream{
+		DiscussionTopics:   make([]DiscussionTopic, 0),
+		Announcements:      make([]Announcement, 0),
+		Conversations:      make([]Conversation, 0),
+		Messages:           make([]Message, 0),
+		Submissions:        make([]SubmissionStreamItem, 0),
+		Conferences:        make([]Conference, 0),
+		Collaborations:     make([]Collaboration, 0),
+		AssessmentRequests: make([]AssessmentRequest, 0),
+		Unknown:            make([]RawStreamItem, 0),
+	}
+
+	for _, raw := range items {
+		t := streamItemType{}
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+
+		var err error
+		switch t.Type {
+		case "DiscussionTopic":
+			d := DiscussionTopic{}
+			err = json.Unmarshal(raw, &d)
+			stream.DiscussionTopics = append(stream.DiscussionTopics, d)
+		case "Announcement":
+			a := Announcement{}
+			err = json.Unmarshal(raw, &a)
+			stream.Announcements = append(stream.Announcements, a)
+		case "Conversation":
+			c := Conversation{}
+			err = json.Unmarshal(raw, &c)
+			stream.Conversations = append(stream.Conversations, c)
+		case "Message":
+			m := Message{}
+			err = json.Unmarshal(raw, &m)
+			stream.Messages = append(stream.Messages, m)
+		case "Submission":
+			sub := SubmissionStreamItem{}
+			err = json.Unmarshal(raw, &sub)
+			stream.Submissions = append(stream.Submissions, sub)
+		case "WebConference", "Conference":
+			c := Conference{}
+			err = json.Unmarshal(raw, &c)
+			stream.Conferences = append(stream.Conferences, c)
+		case "Collaboration":
+			c := Collaboration{}
+			err = json.Unmarshal(raw, &c)
+			stream.Collaborations = append(stream.Collaborations, c)
+		case "AssessmentRequest":
+			a := AssessmentRequest{}
+			err = json.Unmarshal(raw, &a)
+			stream.AssessmentRequests = append(stream.AssessmentRequests, a)
+		default:
+			stream.Unknown = append(stream.Unknown, RawStreamItem{Type: t.Type, Raw: raw})
+		}
+
+		if err != nil {
+			return fmt.Errorf("decoding %s stream item: %w", t.Type, err)
+		}
+	}
+
+	*s = stream
+	return nil
+}
+
+// ActivityStreamSummary is the number of ActivityStream items of every type
+type ActivityStreamSummary []ActivityStreamSummaryItem
+
+// ActivityStreamSummaryItem is the count of ActivityStream items of a single type
+type ActivityStreamSummaryItem struct {
+	Type                 string `json:"type"`
+	NotificationCategory string `json:"notification_category"`
+	UnreadCount          int64  `json:"unread_count"`
+	Count                int64  `json:"count"`
+}
+
+// WithOnlyActiveUsers limits the stream to items from active courses
+func WithOnlyActiveUsers() ActivityStreamOption {
+	return func(aso *ActivityStreamOptions) {
+		aso.onlyActiveCourses = true
+	}
+
+}
+
+// GetActivityStream returns activity stream
+func (c *CanvasClient) GetActivityStream(setters ...ActivityStreamOption) (*ActivityStream, error) {
+	stream := ActivityStream{}
+
+	requestURL, err := c.activityStreamURL("/api/v1/users/self/activity_stream", setters)
+
+	if err != nil {
+		return &stream, err
+	}
+
+	err = c.getJSON(requestURL, &stream)
+
+	if err != nil {
+		return &stream, err
+	}
+
+	return &stream, nil
+}
+
+// GetCourseActivityStream returns activity stream of a course
+func (c *CanvasClient) GetCourseActivityStream(courseID int64) (*ActivityStream, error) {
+	stream := ActivityStream{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/activity_stream", c.ClientURL(), courseID)
+	err := c.getJSON(requestURL, &stream)
+
+	if err != nil {
+		return &stream, err
+	}
+
+	return &stream, nil
+}
+
+// GetGroupActivityStream returns activity stream of a group
+func (c *CanvasClient) GetGroupActivityStream(groupID int64) (*ActivityStream, error) {
+	stream := ActivityStream{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/activity_stream", c.ClientURL(), groupID)
+	err := c.getJSON(requestURL, &stream)
+
+	if err != nil {
+		return &stream, err
+	}
+
+	return &stream, nil
+}
+
+// GetActivityStreamSummary returns the number of items in the activity stream by type
+func (c *CanvasClient) GetActivityStreamSummary(setters ...ActivityStreamOption) (ActivityStreamSummary, error) {
+	summary := make(ActivityStreamSummary, 0)
+
+	requestURL, err := c.activityStreamURL("/api/v1/users/self/activity_stream/summary", setters)
+
+	if err != nil {
+		return summary, err
+	}
+
+	err = c.getJSON(requestURL, &summary)
+
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+// GetCourseActivityStreamSummary returns the number of items in the activity stream of a course by type
+func (c *CanvasClient) GetCourseActivityStreamSummary(courseID int64) (ActivityStreamSummary, error) {
+	summary := make(ActivityStreamSummary, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/activity_stream/summary", c.ClientURL(), courseID)
+	err := c.getJSON(requestURL, &summary)
+
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+// GetGroupActivityStreamSummary returns the number of items in the activity stream of a group by type
+func (c *CanvasClient) GetGroupActivityStreamSummary(groupID int64) (ActivityStreamSummary, error) {
+	summary := make(ActivityStreamSummary, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/activity_stream/summary", c.ClientURL(), groupID)
+	err := c.getJSON(requestURL, &summary)
+
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+// HideStreamItem hides the item with the given stream item id from the activity stream
+func (c *CanvasClient) HideStreamItem(streamItemID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/users/self/activity_stream/%d", c.ClientURL(), streamItemID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+// HideAllStreamItems hides every item in the activity stream
+func (c *CanvasClient) HideAllStreamItems() error {
+	requestURL := fmt.Sprintf("%s/api/v1/users/self/activity_stream", c.ClientURL())
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+// activityStreamURL builds the URL of a user activity stream endpoint with the options applied
+func (c *CanvasClient) activityStreamURL(path string, setters []ActivityStreamOption) (string, error) {
+	args := &ActivityStreamOptions{
+		onlyActiveCourses: false,
+	}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(c.ClientURL() + path)
+
+	if err != nil {
+		return "", err
+	}
+
+	q := parsedURL.Query()
+
+	if args.onlyActiveCourses {
+		q.Add("only_active_courses", strconv.FormatBool(args.onlyActiveCourses))
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	return parsedURL.String(), nil
+}
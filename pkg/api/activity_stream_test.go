@@ -0,0 +1,121 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const activityStreamJSON = `[
+	{
+		"id": 1, "type": "Announcement", "announcement_id": 11, "title": "Welcome",
+		"message": "Hello", "read_state": true, "course_id": 5, "group_id": null,
+		"context_type": "Course", "total_root_discussion_entries": 2,
+		"require_initial_post": false, "html_url": "http://a"
+	},
+	{
+		"id": 2, "type": "DiscussionTopic", "discussion_topic_id": 12, "title": null,
+		"course_id": null, "group_id": 7, "context_type": "Group"
+	},
+	{"id": 3, "type": "Conversation", "conversation_id": 13, "private": true, "participant_count": 3},
+	{"id": 4, "type": "Message", "message_id": 14, "notification_category": "Due Date"},
+	{
+		"id": 5, "type": "Submission", "submission_id": 15, "assignment_id": 20,
+		"grade": "A", "score": 9.5, "workflow_state": "graded",
+		"assignment": {"id": 20, "name": "Essay"}
+	},
+	{"id": 6, "type": "Conference", "web_conference_id": 16},
+	{"id": 7, "type": "Collaboration", "collaboration_id": 17},
+	{"id": 8, "type": "AssessmentRequest", "assessment_request_id": 18},
+	{"id": 9, "type": "Hologram", "title": "from the future"}
+]`
+
+func TestActivityStream_UnmarshalJSON(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/activity_stream").
+		Reply(200).
+		BodyString(activityStreamJSON)
+
+	got, err := client.GetActivityStream()
+	assert.Nil(t, err)
+
+	assert.Len(t, got.Announcements, 1)
+	assert.Equal(t, int64(11), got.Announcements[0].ID)
+	assert.Equal(t, int64(1), got.Announcements[0].StreamItemID)
+	assert.Equal(t, int64(5), got.Announcements[0].CourseID)
+	assert.Equal(t, int64(0), got.Announcements[0].GroupID)
+
+	assert.Len(t, got.DiscussionTopics, 1)
+	assert.Equal(t, int64(12), got.DiscussionTopics[0].ID)
+	assert.Equal(t, int64(0), got.DiscussionTopics[0].CourseID)
+	assert.Equal(t, int64(7), got.DiscussionTopics[0].GroupID)
+
+	assert.Equal(t, int64(13), got.Conversations[0].ID)
+	assert.Equal(t, int64(14), got.Messages[0].ID)
+
+	assert.Len(t, got.Submissions, 1)
+	assert.Equal(t, 9.5, got.Submissions[0].Score)
+	assert.Equal(t, "Essay", got.Submissions[0].Assignment.Name)
+
+	assert.Equal(t, int64(16), got.Conferences[0].ID)
+	assert.Equal(t, int64(17), got.Collaborations[0].ID)
+	assert.Equal(t, int64(18), got.AssessmentRequests[0].ID)
+
+	assert.Len(t, got.Unknown, 1)
+	assert.Equal(t, "Hologram", got.Unknown[0].Type)
+}
+
+func TestActivityStream_UnmarshalJSON_Malformed(t *testing.T) {
+	stream := ActivityStream{}
+
+	err := stream.UnmarshalJSON([]byte(`[{"type": "Message", "message_id": "not a number"}]`))
+	assert.Error(t, err)
+}
+
+func TestCanvasClient_GetActivityStream_OnlyActiveCourses(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/activity_stream").
+		MatchParam("only_active_courses", "true").
+		Reply(200).
+		BodyString("[]")
+
+	_, err := client.GetActivityStream(WithOnlyActiveUsers())
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+func TestCanvasClient_GetActivityStreamSummary(t *testing.T) {
+	defer gock.Off()
+
+	expected := ActivityStreamSummary{
+		{Type: "DiscussionTopic", UnreadCount: 2, Count: 7},
+		{Type: "Conversation", UnreadCount: 0, Count: 3},
+	}
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/activity_stream/summary").
+		Reply(200).
+		JSON(expected)
+
+	got, err := client.GetCourseActivityStreamSummary(5)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, got)
+}
+
+func TestCanvasClient_HideStreamItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/api/v1/users/self/activity_stream/42").
+		Reply(200).
+		JSON(map[string]interface{}{"hidden": true})
+
+	err := client.HideStreamItem(42)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AnnouncementsOptions is an interface for the lookup of announcements
+type AnnouncementsOptions struct {
+	startDate       time.Time
+	endDate         time.Time
+	activeOnly      bool
+	latestOnly      bool
+	includeSections bool
+}
+
+// AnnouncementsOption is an adapter for generating options
+type AnnouncementsOption func(*AnnouncementsOptions)
+
+// WithAnnouncementDates limits the announcements to those posted between the start and end dates
+func WithAnnouncementDates(startDate time.Time, endDate time.Time) AnnouncementsOption {
+	return func(ao *AnnouncementsOptions) {
+		ao.startDate = startDate
+		ao.endDate = endDate
+	}
+}
+
+// WithActiveAnnouncements leaves out announcements that are scheduled for later or locked
+func WithActiveAnnouncements() AnnouncementsOption {
+	return func(ao *AnnouncementsOptions) {
+		ao.activeOnly = true
+	}
+}
+
+// WithLatestAnnouncements returns only the latest announcement of every context
+func WithLatestAnnouncements() AnnouncementsOption {
+	return func(ao *AnnouncementsOptions) {
+		ao.latestOnly = true
+	}
+}
+
+// WithAnnouncementSections includes the sections a section specific announcement is posted to
+func WithAnnouncementSections() AnnouncementsOption {
+	return func(ao *AnnouncementsOptions) {
+		ao.includeSections = true
+	}
+}
+
+// GetAnnouncements returns the announcements of the contexts, such as "course_123".
+// Canvas returns the announcements of the last 14 days unless dates are given
+func (c *CanvasClient) GetAnnouncements(contextCodes []string, setters ...AnnouncementsOption) ([]Discussion, error) {
+	args := &AnnouncementsOptions{}
+	a := make([]Discussion, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/announcements", c.ClientURL()))
+
+	if err != nil {
+		return a, err
+	}
+
+	q := parsedURL.Query()
+
+	for _, code := range contextCodes {
+		q.Add("context_codes[]", code)
+	}
+	if !args.startDate.IsZero() {
+		q.Add("start_date", args.startDate.Format(time.RFC3339))
+	}
+	if !args.endDate.IsZero() {
+		q.Add("end_date", args.endDate.Format(time.RFC3339))
+	}
+	if args.activeOnly {
+		q.Add("active_only", "true")
+	}
+	if args.latestOnly {
+		q.Add("latest_only", "true")
+	}
+	if args.includeSections {
+		q.Add("include[]", "sections")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &a)
+
+	if err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+// CreateAnnouncement posts an announcement to the course.
+// Setting DelayedPostAt schedules the announcement, setting Sections posts it to those sections only.
+// A nil attachment posts the announcement without a file. Announcements are always published
+func (c *CanvasClient) CreateAnnouncement(courseID int64, announcement *Discussion, attachment *FileUpload) (*Discussion, error) {
+	created := Discussion{}
+
+	form, err := discussionForm(announcement, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	form.Set("is_announcement", "true")
+	form.Set("published", "true")
+
+	err = c.sendMultipart("POST", c.discussionURL(courseID, ""), form, "attachment", attachment, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
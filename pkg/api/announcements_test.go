@@ -0,0 +1,93 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetAnnouncements(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/announcements").
+		MatchParam("context_codes[]", "course_5").
+		MatchParam("start_date", "2021-01-01T00:00:00Z").
+		MatchParam("active_only", "true").
+		Reply(200).
+		BodyString(`[{"id": 30, "title": "Welcome", "is_announcement": true, "context_code": "course_5"},
+			{"id": 31, "title": "Labs", "is_announcement": true, "context_code": "course_6",
+			 "is_section_specific": true, "sections": [{"id": 2, "name": "Lab A"}]}]`)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := client.GetAnnouncements([]string{"course_5", "course_6"},
+		WithAnnouncementDates(start, start.AddDate(0, 4, 0)), WithActiveAnnouncements())
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "course_6", got[1].ContextCode)
+	assert.Equal(t, []Section{{ID: 2, Name: "Lab A"}}, got[1].Sections)
+}
+
+func TestCanvasClient_CreateAnnouncement(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/discussion_topics").
+		AddMatcher(matchForm(url.Values{
+			"title":                 {"Term starts"},
+			"message":               {""},
+			"require_initial_post":  {"false"},
+			"podcast_enabled":       {"false"},
+			"allow_rating":          {"false"},
+			"only_graders_can_rate": {"false"},
+			"sort_by_rating":        {"false"},
+			"published":             {"true"},
+			"pinned":                {"false"},
+			"locked":                {"false"},
+			"is_announcement":       {"true"},
+			"delayed_post_at":       {"2021-09-01T08:00:00Z"},
+			"specific_sections":     {"2,3"},
+		})).
+		Reply(200).
+		JSON(Discussion{ID: 32, IsAnnouncement: true, DelayedPostAt: "2021-09-01T08:00:00Z"})
+
+	got, err := client.CreateAnnouncement(5, &Discussion{
+		Title:         "Term starts",
+		DelayedPostAt: "2021-09-01T08:00:00Z",
+		Sections:      []Section{{ID: 2}, {ID: 3}},
+	}, nil)
+
+	assert.Nil(t, err)
+	assert.True(t, got.IsAnnouncement)
+}
+
+func TestCanvasClient_CreateAnnouncementWithAttachment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/discussion_topics").
+		AddMatcher(matchMultipart(url.Values{
+			"title":                 {"Syllabus"},
+			"message":               {""},
+			"require_initial_post":  {"false"},
+			"podcast_enabled":       {"false"},
+			"allow_rating":          {"false"},
+			"only_graders_can_rate": {"false"},
+			"sort_by_rating":        {"false"},
+			"published":             {"true"},
+			"pinned":                {"false"},
+			"locked":                {"false"},
+			"is_announcement":       {"true"},
+		}, "attachment", "syllabus.pdf", "%PDF")).
+		Reply(200).
+		JSON(Discussion{ID: 33, IsAnnouncement: true, Attachments: []File{{ID: 9}}})
+
+	got, err := client.CreateAnnouncement(5, &Discussion{Title: "Syllabus"}, &FileUpload{Name: "syllabus.pdf", Content: strings.NewReader("%PDF")})
+	assert.Nil(t, err)
+	assert.Equal(t, []File{{ID: 9}}, got.Attachments)
+}
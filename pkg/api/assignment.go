@@ -10,10 +10,11 @@ type Assignment struct {
 	AssignmentVisibility            []int64     `json:"assignment_visibility"`
 	AutomaticPeerReviews            bool        `json:"automatic_peer_reviews"`
 	CanSubmit                       bool        `json:"can_submit"`
+	Course                          *Course     `json:"course"`
 	CourseID                        int64       `json:"course_id"`
 	CreatedAt                       string      `json:"created_at"`
 	Description                     string      `json:"description"`
-	DiscussionTopic                 interface{} `json:"discussion_topic"`
+	DiscussionTopic                 *Discussion `json:"discussion_topic"`
 	DueAt                           string      `json:"due_at"`
 	DueDateRequired                 bool        `json:"due_date_required"`
 	ExternalToolTagAttributes       interface{} `json:"external_tool_tag_attributes"`
@@ -26,7 +27,7 @@ type Assignment struct {
 	GraderCount                     int64       `json:"grader_count"`
 	GraderNamesVisibleToFinalGrader bool        `json:"grader_names_visible_to_final_grader"`
 	GradersAnonymousToGraders       bool        `json:"graders_anonymous_to_graders"`
-	GradingStandardID               interface{} `json:"grading_standard_id"`
+	GradingStandardID               int64       `json:"grading_standard_id"`
 	GradingType                     string      `json:"grading_type"`
 	GroupCategoryID                 int64       `json:"group_category_id"`
 	HasOverrides                    bool        `json:"has_overrides"`
@@ -47,29 +48,30 @@ type Assignment struct {
 		NeedsGradingCount int64  `json:"needs_grading_count"`
 		SectionID         string `json:"section_id"`
 	} `json:"needs_grading_count_by_section"`
-	OmitFromFinalGrade     bool        `json:"omit_from_final_grade"`
-	OnlyVisibleToOverrides bool        `json:"only_visible_to_overrides"`
-	Overrides              interface{} `json:"overrides"`
-	PeerReviewCount        int64       `json:"peer_review_count"`
-	PeerReviews            bool        `json:"peer_reviews"`
-	PeerReviewsAssignAt    string      `json:"peer_reviews_assign_at"`
-	PointsPossible         int64       `json:"points_possible"`
-	Position               int64       `json:"position"`
-	PostManually           bool        `json:"post_manually"`
-	PostToSis              bool        `json:"post_to_sis"`
-	Published              bool        `json:"published"`
-	QuizID                 int64       `json:"quiz_id"`
-	Rubric                 interface{} `json:"rubric"`
-	RubricSettings         interface{} `json:"rubric_settings"`
-	ScoreStatistics        interface{} `json:"score_statistics"`
-	Submission             interface{} `json:"submission"`
-	SubmissionTypes        []string    `json:"submission_types"`
-	SubmissionsDownloadURL string      `json:"submissions_download_url"`
-	TurnitinEnabled        bool        `json:"turnitin_enabled"`
-	TurnitinSettings       interface{} `json:"turnitin_settings"`
-	UnlockAt               string      `json:"unlock_at"`
-	Unpublishable          bool        `json:"unpublishable"`
-	UpdatedAt              string      `json:"updated_at"`
-	UseRubricForGrading    bool        `json:"use_rubric_for_grading"`
-	VericiteEnabled        bool        `json:"vericite_enabled"`
+	OmitFromFinalGrade     bool              `json:"omit_from_final_grade"`
+	OnlyVisibleToOverrides bool              `json:"only_visible_to_overrides"`
+	Overrides              interface{}       `json:"overrides"`
+	PeerReviewCount        int64             `json:"peer_review_count"`
+	PeerReviews            bool              `json:"peer_reviews"`
+	PeerReviewsAssignAt    string            `json:"peer_reviews_assign_at"`
+	PlannerOverride        *PlannerOverride  `json:"planner_override"`
+	PointsPossible         float64           `json:"points_possible"`
+	Position               int64             `json:"position"`
+	PostManually           bool              `json:"post_manually"`
+	PostToSis              bool              `json:"post_to_sis"`
+	Published              bool              `json:"published"`
+	QuizID                 int64             `json:"quiz_id"`
+	Rubric                 []RubricCriterion `json:"rubric"`
+	RubricSettings         *RubricSettings   `json:"rubric_settings"`
+	ScoreStatistics        interface{}       `json:"score_statistics"`
+	Submission             interface{}       `json:"submission"`
+	SubmissionTypes        []string          `json:"submission_types"`
+	SubmissionsDownloadURL string            `json:"submissions_download_url"`
+	TurnitinEnabled        bool              `json:"turnitin_enabled"`
+	TurnitinSettings       interface{}       `json:"turnitin_settings"`
+	UnlockAt               string            `json:"unlock_at"`
+	Unpublishable          bool              `json:"unpublishable"`
+	UpdatedAt              string            `json:"updated_at"`
+	UseRubricForGrading    bool              `json:"use_rubric_for_grading"`
+	VericiteEnabled        bool              `json:"vericite_enabled"`
 }
@@ -0,0 +1,540 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarEvent is an event or an assignment on the calendar
+type CalendarEvent struct {
+	// ID is the id of the event, or of the assignment for assignment events
+	ID                    int64  `json:"id"`
+	Type                  string `json:"type"`
+	Title                 string `json:"title"`
+	Description           string `json:"description"`
+	StartAt               string `json:"start_at"`
+	EndAt                 string `json:"end_at"`
+	AllDay                bool   `json:"all_day"`
+	AllDayDate            string `json:"all_day_date"`
+	LocationName          string `json:"location_name"`
+	LocationAddress       string `json:"location_address"`
+	ContextCode           string `json:"context_code"`
+	EffectiveContextCode  string `json:"effective_context_code"`
+	ContextName           string `json:"context_name"`
+	AllContextCodes       string `json:"all_context_codes"`
+	WorkflowState         string `json:"workflow_state"`
+	Hidden                bool   `json:"hidden"`
+	ParentEventID         int64  `json:"parent_event_id"`
+	ChildEventsCount      int64  `json:"child_events_count"`
+	URL                   string `json:"url"`
+	HTMLURL               string `json:"html_url"`
+	CreatedAt             string `json:"created_at"`
+	UpdatedAt             string `json:"updated_at"`
+	ImportantDates        bool   `json:"important_dates"`
+	SeriesUUID            string `json:"series_uuid"`
+	RRule                 string `json:"rrule"`
+	SeriesHead            bool   `json:"series_head"`
+	SeriesNaturalLanguage string `json:"series_natural_language"`
+	BlackoutDate          bool   `json:"blackout_date"`
+
+	AppointmentGroupID         int64  `json:"appointment_group_id"`
+	AppointmentGroupURL        string `json:"appointment_group_url"`
+	OwnReservation             bool   `json:"own_reservation"`
+	ReserveURL                 string `json:"reserve_url"`
+	Reserved                   bool   `json:"reserved"`
+	ParticipantType            string `json:"participant_type"`
+	ParticipantsPerAppointment int64  `json:"participants_per_appointment"`
+	AvailableSlots             int64  `json:"available_slots"`
+
+	ChildEvents []CalendarEvent `json:"child_events"`
+	Assignment  *Assignment     `json:"assignment"`
+}
+
+// AppointmentGroup is a set of time slots that participants can sign up for
+type AppointmentGroup struct {
+	ID                            int64           `json:"id"`
+	Title                         string          `json:"title"`
+	Description                   string          `json:"description"`
+	StartAt                       string          `json:"start_at"`
+	EndAt                         string          `json:"end_at"`
+	LocationName                  string          `json:"location_name"`
+	LocationAddress               string          `json:"location_address"`
+	ParticipantCount              int64           `json:"participant_count"`
+	ContextCodes                  []string        `json:"context_codes"`
+	SubContextCodes               []string        `json:"sub_context_codes"`
+	WorkflowState                 string          `json:"workflow_state"`
+	RequiringAction               bool            `json:"requiring_action"`
+	AppointmentsCount             int64           `json:"appointments_count"`
+	Appointments                  []CalendarEvent `json:"appointments"`
+	ReservedTimes                 []CalendarEvent `json:"reserved_times"`
+	MaxAppointmentsPerParticipant int64           `json:"max_appointments_per_participant"`
+	MinAppointmentsPerParticipant int64           `json:"min_appointments_per_participant"`
+	ParticipantsPerAppointment    int64           `json:"participants_per_appointment"`
+	ParticipantVisibility         string          `json:"participant_visibility"`
+	ParticipantType               string          `json:"participant_type"`
+	URL                           string          `json:"url"`
+	HTMLURL                       string          `json:"html_url"`
+	CreatedAt                     string          `json:"created_at"`
+	UpdatedAt                     string          `json:"updated_at"`
+
+	// NewAppointments are the start and end times of the slots to create with the group
+	NewAppointments [][2]string `json:"-"`
+}
+
+// UnmarshalJSON decodes the event, accepting the "assignment_123" ids canvas uses for assignment events
+func (e *CalendarEvent) UnmarshalJSON(data []byte) error {
+	type calendarEvent CalendarEvent
+	aux := struct {
+		*calendarEvent
+		ID json.RawMessage `json:"id"`
+	}{calendarEvent: (*calendarEvent)(e)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.ID) == 0 || string(aux.ID) == "null" {
+		return nil
+	}
+
+	var id string
+	if err := json.Unmarshal(aux.ID, &id); err != nil {
+		return json.Unmarshal(aux.ID, &e.ID)
+	}
+
+	parsed, err := strconv.ParseInt(id[strings.LastIndex(id, "_")+1:], 10, 64)
+	if err != nil {
+		return err
+	}
+	e.ID = parsed
+
+	return nil
+}
+
+// CalendarEventsOptions is an interface for the lookup of calendar events
+type CalendarEventsOptions struct {
+	eventType    string
+	startDate    time.Time
+	endDate      time.Time
+	undated      bool
+	allEvents    bool
+	contextCodes []string
+	err          []error
+}
+
+// CalendarEventsOption is an adapter for generating options
+type CalendarEventsOption func(*CalendarEventsOptions)
+
+// WithCalendarEventType is a calendar event type filter
+// Type can only be one of: {"event" | "assignment"}
+func WithCalendarEventType(eventType string) CalendarEventsOption {
+	if eventType != "event" && eventType != "assignment" {
+		return func(ceo *CalendarEventsOptions) {
+			ceo.err = append(ceo.err, errors.New("keyword type can be only one of: 'event' | 'assignment'"))
+		}
+	}
+	return func(ceo *CalendarEventsOptions) {
+		ceo.eventType = eventType
+	}
+}
+
+// WithCalendarEventDates limits the calendar to events between the start and end dates
+func WithCalendarEventDates(startDate time.Time, endDate time.Time) CalendarEventsOption {
+	return func(ceo *CalendarEventsOptions) {
+		ceo.startDate = startDate
+		ceo.endDate = endDate
+	}
+}
+
+// WithUndatedEvents returns only events without a date
+func WithUndatedEvents() CalendarEventsOption {
+	return func(ceo *CalendarEventsOptions) {
+		ceo.undated = true
+	}
+}
+
+// WithAllEvents returns every event, ignoring the dates
+func WithAllEvents() CalendarEventsOption {
+	return func(ceo *CalendarEventsOptions) {
+		ceo.allEvents = true
+	}
+}
+
+// WithCalendarContextCodes limits the calendar to the contexts, such as "course_123" or "user_456"
+func WithCalendarContextCodes(contextCodes ...string) CalendarEventsOption {
+	return func(ceo *CalendarEventsOptions) {
+		ceo.contextCodes = append(ceo.contextCodes, contextCodes...)
+	}
+}
+
+// GetCalendarEvents returns the calendar events of the user
+func (c *CanvasClient) GetCalendarEvents(setters ...CalendarEventsOption) ([]CalendarEvent, error) {
+	return c.getCalendarEvents("/api/v1/calendar_events", setters)
+}
+
+// GetUserCalendarEvents returns the calendar events of an observed user
+func (c *CanvasClient) GetUserCalendarEvents(userID int64, setters ...CalendarEventsOption) ([]CalendarEvent, error) {
+	return c.getCalendarEvents(fmt.Sprintf("/api/v1/users/%d/calendar_events", userID), setters)
+}
+
+func (c *CanvasClient) getCalendarEvents(path string, setters []CalendarEventsOption) ([]CalendarEvent, error) {
+	args := &CalendarEventsOptions{}
+	e := make([]CalendarEvent, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return e, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(c.ClientURL() + path)
+
+	if err != nil {
+		return e, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.eventType != "" {
+		q.Add("type", args.eventType)
+	}
+	if !args.startDate.IsZero() {
+		q.Add("start_date", args.startDate.Format(time.RFC3339))
+	}
+	if !args.endDate.IsZero() {
+		q.Add("end_date", args.endDate.Format(time.RFC3339))
+	}
+	if args.undated {
+		q.Add("undated", "true")
+	}
+	if args.allEvents {
+		q.Add("all_events", "true")
+	}
+	for _, code := range args.contextCodes {
+		q.Add("context_codes[]", code)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &e)
+
+	if err != nil {
+		return e, err
+	}
+
+	return e, nil
+}
+
+// GetCalendarEvent returns the calendar event with the given eventID
+func (c *CanvasClient) GetCalendarEvent(eventID int64) (*CalendarEvent, error) {
+	event := CalendarEvent{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/calendar_events/%d", c.ClientURL(), eventID)
+	err := c.getJSON(requestURL, &event)
+
+	if err != nil {
+		return &event, err
+	}
+
+	return &event, nil
+}
+
+// CreateCalendarEvent creates a calendar event in the context of the event.
+// Setting RRule creates a series of recurring events
+func (c *CanvasClient) CreateCalendarEvent(event *CalendarEvent) (*CalendarEvent, error) {
+	created := CalendarEvent{}
+
+	form, err := calendarEventForm(event, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/calendar_events", c.ClientURL())
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateCalendarEvent updates the named fields of the calendar event, such as "title" or "start_at", to match the given one.
+// Which selects the events of a series to update and can only be one of: {"one" | "all" | "following" | ""}
+func (c *CanvasClient) UpdateCalendarEvent(event *CalendarEvent, which string, fields ...string) (*CalendarEvent, error) {
+	updated := CalendarEvent{}
+
+	if err := validateWhich(which); err != nil {
+		return &updated, err
+	}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := calendarEventForm(event, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	if which != "" {
+		form.Add("which", which)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/calendar_events/%d", c.ClientURL(), event.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteCalendarEvent deletes the calendar event.
+// Which selects the events of a series to delete and can only be one of: {"one" | "all" | "following" | ""}
+func (c *CanvasClient) DeleteCalendarEvent(eventID int64, which string) error {
+	if err := validateWhich(which); err != nil {
+		return err
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/calendar_events/%d", c.ClientURL(), eventID))
+
+	if err != nil {
+		return err
+	}
+
+	q := parsedURL.Query()
+
+	if which != "" {
+		q.Add("which", which)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	return c.sendJSON("DELETE", parsedURL.String(), nil, nil)
+}
+
+func validateWhich(which string) error {
+	if which != "one" && which != "all" && which != "following" && which != "" {
+		return errors.New("keyword which can be only one of: 'one' | 'all' | 'following'")
+	}
+	return nil
+}
+
+func calendarEventForm(event *CalendarEvent, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("context_code", event.ContextCode != "") {
+		form.Add("calendar_event[context_code]", event.ContextCode)
+	}
+	if fields.has("title", true) {
+		form.Add("calendar_event[title]", event.Title)
+	}
+	if fields.has("description", true) {
+		form.Add("calendar_event[description]", event.Description)
+	}
+	if fields.has("start_at", true) {
+		form.Add("calendar_event[start_at]", event.StartAt)
+	}
+	if fields.has("end_at", true) {
+		form.Add("calendar_event[end_at]", event.EndAt)
+	}
+	if fields.has("location_name", true) {
+		form.Add("calendar_event[location_name]", event.LocationName)
+	}
+	if fields.has("location_address", true) {
+		form.Add("calendar_event[location_address]", event.LocationAddress)
+	}
+	if fields.has("all_day", true) {
+		form.Add("calendar_event[all_day]", strconv.FormatBool(event.AllDay))
+	}
+	if fields.has("rrule", event.RRule != "") {
+		form.Add("calendar_event[rrule]", event.RRule)
+	}
+	if fields.has("blackout_date", event.BlackoutDate) {
+		form.Add("calendar_event[blackout_date]", strconv.FormatBool(event.BlackoutDate))
+	}
+	return form, fields.unknown()
+}
+
+// AppointmentGroupsOptions is an interface for the lookup of appointment groups
+type AppointmentGroupsOptions struct {
+	scope                   string
+	contextCodes            []string
+	includePastAppointments bool
+	err                     []error
+}
+
+// AppointmentGroupsOption is an adapter for generating options
+type AppointmentGroupsOption func(*AppointmentGroupsOptions)
+
+// WithAppointmentGroupScope is an appointment group filter
+// Scope can only be one of: {"reservable" | "manageable"}
+func WithAppointmentGroupScope(scope string) AppointmentGroupsOption {
+	if scope != "reservable" && scope != "manageable" {
+		return func(ago *AppointmentGroupsOptions) {
+			ago.err = append(ago.err, errors.New("keyword scope can be only one of: 'reservable' | 'manageable'"))
+		}
+	}
+	return func(ago *AppointmentGroupsOptions) {
+		ago.scope = scope
+	}
+}
+
+// WithAppointmentGroupContextCodes limits the appointment groups to the course contexts
+func WithAppointmentGroupContextCodes(contextCodes ...string) AppointmentGroupsOption {
+	return func(ago *AppointmentGroupsOptions) {
+		ago.contextCodes = append(ago.contextCodes, contextCodes...)
+	}
+}
+
+// WithPastAppointments includes appointment groups with no future slots
+func WithPastAppointments() AppointmentGroupsOption {
+	return func(ago *AppointmentGroupsOptions) {
+		ago.includePastAppointments = true
+	}
+}
+
+// GetAppointmentGroups returns the appointment groups the user can reserve or manage
+func (c *CanvasClient) GetAppointmentGroups(setters ...AppointmentGroupsOption) ([]AppointmentGroup, error) {
+	args := &AppointmentGroupsOptions{}
+	g := make([]AppointmentGroup, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return g, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/appointment_groups", c.ClientURL()))
+
+	if err != nil {
+		return g, err
+	}
+
+	q := parsedURL.Query()
+
+	q.Add("include[]", "appointments")
+	if args.scope != "" {
+		q.Add("scope", args.scope)
+	}
+	for _, code := range args.contextCodes {
+		q.Add("context_codes[]", code)
+	}
+	if args.includePastAppointments {
+		q.Add("include_past_appointments", "true")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetAppointmentGroup returns the appointment group with the given groupID
+func (c *CanvasClient) GetAppointmentGroup(groupID int64) (*AppointmentGroup, error) {
+	group := AppointmentGroup{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/appointment_groups/%d?include[]=appointments", c.ClientURL(), groupID)
+	err := c.getJSON(requestURL, &group)
+
+	if err != nil {
+		return &group, err
+	}
+
+	return &group, nil
+}
+
+// CreateAppointmentGroup creates an appointment group with the slots in NewAppointments.
+// The group is published right away when publish is set
+func (c *CanvasClient) CreateAppointmentGroup(group *AppointmentGroup, publish bool) (*AppointmentGroup, error) {
+	created := AppointmentGroup{}
+
+	form := url.Values{}
+	for _, code := range group.ContextCodes {
+		form.Add("appointment_group[context_codes][]", code)
+	}
+	for _, code := range group.SubContextCodes {
+		form.Add("appointment_group[sub_context_codes][]", code)
+	}
+	form.Add("appointment_group[title]", group.Title)
+	form.Add("appointment_group[description]", group.Description)
+	form.Add("appointment_group[location_name]", group.LocationName)
+	form.Add("appointment_group[location_address]", group.LocationAddress)
+	form.Add("appointment_group[publish]", strconv.FormatBool(publish))
+	if group.ParticipantsPerAppointment != 0 {
+		form.Add("appointment_group[participants_per_appointment]", strconv.FormatInt(group.ParticipantsPerAppointment, 10))
+	}
+	if group.MinAppointmentsPerParticipant != 0 {
+		form.Add("appointment_group[min_appointments_per_participant]", strconv.FormatInt(group.MinAppointmentsPerParticipant, 10))
+	}
+	if group.MaxAppointmentsPerParticipant != 0 {
+		form.Add("appointment_group[max_appointments_per_participant]", strconv.FormatInt(group.MaxAppointmentsPerParticipant, 10))
+	}
+	if group.ParticipantVisibility != "" {
+		form.Add("appointment_group[participant_visibility]", group.ParticipantVisibility)
+	}
+	for i, slot := range group.NewAppointments {
+		key := fmt.Sprintf("appointment_group[new_appointments][%d][]", i)
+		form.Add(key, slot[0])
+		form.Add(key, slot[1])
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/appointment_groups", c.ClientURL())
+	err := c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// DeleteAppointmentGroup deletes the appointment group with the given groupID
+func (c *CanvasClient) DeleteAppointmentGroup(groupID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/appointment_groups/%d", c.ClientURL(), groupID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+// ReserveAppointment reserves the time slot with the given eventID for the user.
+// When cancelExisting is set, the users other reservations in the group are cancelled
+func (c *CanvasClient) ReserveAppointment(eventID int64, comments string, cancelExisting bool) (*CalendarEvent, error) {
+	reservation := CalendarEvent{}
+
+	form := url.Values{}
+	if comments != "" {
+		form.Add("comments", comments)
+	}
+	form.Add("cancel_existing", strconv.FormatBool(cancelExisting))
+
+	requestURL := fmt.Sprintf("%s/api/v1/calendar_events/%d/reservations", c.ClientURL(), eventID)
+	err := c.sendJSON("POST", requestURL, form, &reservation)
+
+	if err != nil {
+		return &reservation, err
+	}
+
+	return &reservation, nil
+}
+
+// UnreserveAppointment cancels the reservation with the given reservationID
+func (c *CanvasClient) UnreserveAppointment(reservationID int64) error {
+	return c.DeleteCalendarEvent(reservationID, "")
+}
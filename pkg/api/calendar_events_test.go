@@ -0,0 +1,116 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetCalendarEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/calendar_events").
+		MatchParam("type", "assignment").
+		MatchParam("start_date", "2021-03-01T00:00:00Z").
+		MatchParam("context_codes[]", "course_5").
+		Reply(200).
+		BodyString(`[{"id": "assignment_20", "title": "Essay", "assignment": {"id": 20, "due_at": "2021-03-02T05:59:59Z"}}]`)
+
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	got, err := client.GetCalendarEvents(
+		WithCalendarEventType("assignment"),
+		WithCalendarEventDates(start, start.AddDate(0, 1, 0)),
+		WithCalendarContextCodes("course_5"),
+	)
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, int64(20), got[0].ID)
+	assert.Equal(t, "2021-03-02T05:59:59Z", got[0].Assignment.DueAt)
+
+	_, err = client.GetCalendarEvents(WithCalendarEventType("holiday"))
+	assert.Error(t, err)
+}
+
+func TestCanvasClient_CreateCalendarEvent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/calendar_events").
+		BodyString("calendar_event%5Brrule%5D=FREQ%3DWEEKLY%3BCOUNT%3D10").
+		Reply(200).
+		JSON(map[string]interface{}{"id": 7, "title": "Lab", "series_uuid": "abc"})
+
+	got, err := client.CreateCalendarEvent(&CalendarEvent{
+		ContextCode: "course_5",
+		Title:       "Lab",
+		StartAt:     "2021-03-02T15:00:00Z",
+		EndAt:       "2021-03-02T16:00:00Z",
+		RRule:       "FREQ=WEEKLY;COUNT=10",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), got.ID)
+	assert.Equal(t, "abc", got.SeriesUUID)
+}
+
+func TestCanvasClient_UpdateCalendarEvent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/calendar_events/7").
+		AddMatcher(matchForm(url.Values{
+			"calendar_event[title]":    {"Lab B"},
+			"calendar_event[start_at]": {"2021-03-02T16:00:00Z"},
+			"which":                    {"following"},
+		})).
+		Reply(200).
+		JSON(map[string]interface{}{"id": 7, "title": "Lab B"})
+
+	got, err := client.UpdateCalendarEvent(&CalendarEvent{ID: 7, Title: "Lab B", StartAt: "2021-03-02T16:00:00Z"}, "following", "title", "start_at")
+	assert.Nil(t, err)
+	assert.Equal(t, "Lab B", got.Title)
+
+	_, err = client.UpdateCalendarEvent(&CalendarEvent{ID: 7}, "")
+	assert.EqualError(t, err, "no fields to update")
+
+	_, err = client.UpdateCalendarEvent(&CalendarEvent{ID: 7, Title: "Lab B"}, "", "titel")
+	assert.EqualError(t, err, `unknown fields to update: "titel"`)
+}
+
+func TestCanvasClient_DeleteCalendarEvent(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/api/v1/calendar_events/7").
+		MatchParam("which", "following").
+		Reply(200).
+		JSON(map[string]interface{}{"id": 7})
+
+	err := client.DeleteCalendarEvent(7, "following")
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+
+	err = client.DeleteCalendarEvent(7, "some")
+	assert.Error(t, err)
+}
+
+func TestCanvasClient_ReserveAppointment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/calendar_events/30/reservations").
+		BodyString("cancel_existing=true").
+		Reply(200).
+		JSON(map[string]interface{}{"id": 31, "parent_event_id": 30, "appointment_group_id": 4})
+
+	got, err := client.ReserveAppointment(30, "", true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(31), got.ID)
+	assert.Equal(t, int64(30), got.ParentEventID)
+	assert.Equal(t, int64(4), got.AppointmentGroupID)
+}
@@ -1,16 +1,38 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // CanvasClient stores data releveant to the operation of canvas api
 type CanvasClient struct {
-	Domain  string
-	client  *http.Client
-	headers *http.Header
+	Domain      string
+	client      *http.Client
+	headers     *http.Header
+	tokenSource TokenSource
+}
+
+// TokenSource supplies the access token that is sent with every request
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// RefreshableTokenSource is a TokenSource that can renew its token once canvas rejects it
+type RefreshableTokenSource interface {
+	TokenSource
+	Refresh() (*Token, error)
 }
 
 // NewClient creates new client
@@ -26,31 +48,365 @@ func NewClient(domain string, authorizationToken string) *CanvasClient {
 	return &c
 }
 
+// NewClientWithTokenSource creates new client that authorizes requests with tokens from the source
+func NewClientWithTokenSource(domain string, tokenSource TokenSource) *CanvasClient {
+	c := CanvasClient{
+		Domain:      domain,
+		client:      http.DefaultClient,
+		headers:     &http.Header{},
+		tokenSource: tokenSource,
+	}
+
+	return &c
+}
+
 // ClientURL returns a complete client URL
 func (c *CanvasClient) ClientURL() string {
-	return fmt.Sprintf("https://%s.instructure.com", c.Domain)
+	return canvasURL(c.Domain)
+}
+
+// canvasURL returns the root URL of the canvas instance for the domain
+func canvasURL(domain string) string {
+	return fmt.Sprintf("https://%s.instructure.com", domain)
+}
+
+// contextURL returns the API URL of the context with the given code, such as "course_123" or "group_456"
+func (c *CanvasClient) contextURL(contextCode string) (string, error) {
+	i := strings.LastIndex(contextCode, "_")
+	if i == -1 {
+		return "", fmt.Errorf("invalid context code: %q", contextCode)
+	}
+
+	context, id := contextCode[:i], contextCode[i+1:]
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid context code: %q", contextCode)
+	}
+
+	switch context {
+	case "course", "group", "user", "account":
+		return fmt.Sprintf("%s/api/v1/%ss/%s", c.ClientURL(), context, id), nil
+	}
+
+	return "", fmt.Errorf("invalid context code: %q", contextCode)
+}
+
+// formFields selects the fields a form of a create or an update request holds.
+// A nil formFields, used by creates, selects every field that is set.
+// Updates select exactly the named fields, so the fields they leave out keep their value in canvas.
+// It maps each named field to whether the form builder has handled it
+type formFields map[string]bool
+
+// updateFields returns the formFields of an update of the named fields, such as "title" or "due_at"
+func updateFields(fields []string) (formFields, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	f := formFields{}
+	for _, field := range fields {
+		f[field] = false
+	}
+
+	return f, nil
+}
+
+// has reports whether the form holds the field, set tells whether the field has a value a create should send
+func (f formFields) has(field string, set bool) bool {
+	if f == nil {
+		return set
+	}
+
+	if _, ok := f[field]; !ok {
+		return false
+	}
+	f[field] = true
+
+	return true
+}
+
+// unknown returns an error naming the fields the form builder has not handled, which it does not know
+func (f formFields) unknown() error {
+	names := make([]string, 0)
+	for field, handled := range f {
+		if !handled {
+			names = append(names, strconv.Quote(field))
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+
+	return fmt.Errorf("unknown fields to update: %s", strings.Join(names, ", "))
 }
 
 // getJSON is a hidden method that is used in the background to create GET requests and
 // Unpack the responses into the passed in struct
 func (c *CanvasClient) getJSON(url string, target interface{}) error {
-	req, err := http.NewRequest("GET", url, nil)
+	return c.sendJSON("GET", url, nil, target)
+}
+
+// requestBody is the encoded body of a request, kept so the request can be retried
+type requestBody struct {
+	contentType string
+	data        []byte
+}
+
+// sendJSON sends a request with the form as its body and unpacks the response into target.
+// A nil target discards the response body
+func (c *CanvasClient) sendJSON(method string, requestURL string, form url.Values, target interface{}) error {
+	var body *requestBody
+	if form != nil {
+		body = &requestBody{
+			contentType: "application/x-www-form-urlencoded",
+			data:        []byte(form.Encode()),
+		}
+	}
+
+	return c.sendBody(method, requestURL, body, target)
+}
+
+// sendJSONBody sends a request with payload encoded as JSON as its body and unpacks the response into target.
+// It is used for parameters that cannot be expressed as a form, such as nested answers
+func (c *CanvasClient) sendJSONBody(method string, requestURL string, payload interface{}, target interface{}) error {
+	data, err := json.Marshal(payload)
 
 	if err != nil {
 		return err
 	}
 
-	req.Header = *c.headers
-	res, err := c.client.Do(req)
+	return c.sendBody(method, requestURL, &requestBody{contentType: "application/json", data: data}, target)
+}
+
+// sendMultipart sends a request with the form and the file as a multipart body and unpacks the response into target.
+// A nil file sends only the form
+func (c *CanvasClient) sendMultipart(method string, requestURL string, form url.Values, field string, file *FileUpload, target interface{}) error {
+	if file == nil {
+		return c.sendJSON(method, requestURL, form, target)
+	}
+
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+
+	for key, values := range form {
+		for _, value := range values {
+			if err := w.WriteField(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	part, err := w.CreateFormFile(field, file.Name)
 
 	if err != nil {
 		return err
 	}
 
-	if res.StatusCode != 200 {
-		return fmt.Errorf("Status code is: %d", res.StatusCode)
+	if _, err = io.Copy(part, file.Content); err != nil {
+		return err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return c.sendBody(method, requestURL, &requestBody{contentType: w.FormDataContentType(), data: buf.Bytes()}, target)
+}
+
+func (c *CanvasClient) sendBody(method string, requestURL string, body *requestBody, target interface{}) error {
+	res, err := c.do(method, requestURL, body)
+
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(res, target)
+}
+
+// getPaginatedJSON follows the next links of a paginated listing and appends every page
+// to the slice that target points to
+func (c *CanvasClient) getPaginatedJSON(requestURL string, target interface{}) error {
+	return c.paginate(requestURL, target, func(slice reflect.Value) reflect.Value {
+		return reflect.New(slice.Type())
+	}, reflect.AppendSlice)
+}
+
+// getPaginatedObjects follows the next links of a paginated listing whose pages are objects
+// rather than lists, and appends every page as an element of the slice that target points to
+func (c *CanvasClient) getPaginatedObjects(requestURL string, target interface{}) error {
+	return c.paginate(requestURL, target, func(slice reflect.Value) reflect.Value {
+		return reflect.New(slice.Type().Elem())
+	}, func(slice reflect.Value, page reflect.Value) reflect.Value {
+		return reflect.Append(slice, page)
+	})
+}
+
+func (c *CanvasClient) paginate(requestURL string, target interface{}, newPage func(reflect.Value) reflect.Value, appendPage func(reflect.Value, reflect.Value) reflect.Value) error {
+	slice := reflect.ValueOf(target)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return errors.New("target must be a pointer to a slice")
+	}
+
+	for requestURL != "" {
+		page := newPage(slice.Elem())
+		next, err := c.getJSONPage(requestURL, page.Interface())
+
+		if err != nil {
+			return err
+		}
+
+		slice.Elem().Set(appendPage(slice.Elem(), page.Elem()))
+		requestURL = next
+	}
+
+	return nil
+}
+
+// getJSONPage unpacks a single page of a listing into target and returns the URL of the next page
+func (c *CanvasClient) getJSONPage(requestURL string, target interface{}) (string, error) {
+	res, err := c.do("GET", requestURL, nil)
+
+	if err != nil {
+		return "", err
 	}
 	defer res.Body.Close()
 
+	err = decodeResponse(res, target)
+
+	if err != nil {
+		return "", err
+	}
+
+	return nextPageURL(res.Header), nil
+}
+
+// getText returns the body of a response that is not JSON, such as a CSV export
+func (c *CanvasClient) getText(requestURL string) (string, error) {
+	res, err := c.do("GET", requestURL, nil)
+
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return "", fmt.Errorf("Status code is: %d", res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// graphQLResponse is the envelope canvas wraps the result of a GraphQL query in
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQL runs the query against the GraphQL API of canvas and unpacks its data into target.
+// It is used for operations that have no REST endpoint, such as posting grades
+func (c *CanvasClient) graphQL(query string, variables map[string]interface{}, target interface{}) error {
+	r := graphQLResponse{}
+
+	payload := map[string]interface{}{"query": query, "variables": variables}
+	err := c.sendJSONBody("POST", c.ClientURL()+"/api/graphql", payload, &r)
+
+	if err != nil {
+		return err
+	}
+
+	if len(r.Errors) > 0 {
+		return fmt.Errorf("graphql: %s", r.Errors[0].Message)
+	}
+
+	return json.Unmarshal(r.Data, target)
+}
+
+// decodeResponse unpacks the body of a successful response into target
+func decodeResponse(res *http.Response, target interface{}) error {
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("Status code is: %d", res.StatusCode)
+	}
+
+	if target == nil {
+		return nil
+	}
+
 	return json.NewDecoder(res.Body).Decode(&target)
 }
+
+// nextPageURL returns the URL of the next page from the Link header canvas uses for pagination
+func nextPageURL(header http.Header) string {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+
+	return ""
+}
+
+// do sends an authorized request, renewing the token and retrying once when canvas returns 401
+func (c *CanvasClient) do(method string, requestURL string, body *requestBody) (*http.Response, error) {
+	res, err := c.send(method, requestURL, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	refresher, ok := c.tokenSource.(RefreshableTokenSource)
+	if res.StatusCode != http.StatusUnauthorized || !ok {
+		return res, nil
+	}
+	res.Body.Close()
+
+	if _, err := refresher.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return c.send(method, requestURL, body)
+}
+
+func (c *CanvasClient) send(method string, requestURL string, body *requestBody) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body.data)
+	}
+
+	req, err := http.NewRequest(method, requestURL, reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = c.headers.Clone()
+	if body != nil {
+		req.Header.Set("Content-Type", body.contentType)
+	}
+
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
+
+	return c.client.Do(req)
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clientAssertionType is the assertion type canvas expects for signed JWT client assertions
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// tokenExpiryLeeway is how long before expiry a cached token is renewed
+const tokenExpiryLeeway = time.Minute
+
+// ClientCredentialsConfig is an LTI Advantage developer key used for the client_credentials grant
+type ClientCredentialsConfig struct {
+	Domain     string
+	ClientID   string
+	KeyID      string
+	PrivateKey *rsa.PrivateKey
+	Scopes     []string
+}
+
+// ParseRSAPrivateKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key
+func ParseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// Assertion returns a client assertion JWT signed with RS256 that is valid for the lifetime
+func (c *ClientCredentialsConfig) Assertion(lifetime time.Duration) (string, error) {
+	if c.PrivateKey == nil {
+		return "", errors.New("private key is required to sign the client assertion")
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	header := map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": c.KeyID,
+	}
+	claims := map[string]interface{}{
+		"iss": c.ClientID,
+		"sub": c.ClientID,
+		"aud": fmt.Sprintf("%s/login/oauth2/token", canvasURL(c.Domain)),
+		"iat": now.Unix(),
+		"exp": now.Add(lifetime).Unix(),
+		"jti": hex.EncodeToString(jti),
+	}
+
+	encodedHeader, err := encodeJWTSegment(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeJWTSegment(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encodedHeader + "." + encodedClaims
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func encodeJWTSegment(segment interface{}) (string, error) {
+	b, err := json.Marshal(segment)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Token requests a new scoped access token with a freshly signed client assertion
+func (c *ClientCredentialsConfig) Token() (*Token, error) {
+	assertion, err := c.Assertion(5 * time.Minute)
+
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Add("grant_type", "client_credentials")
+	form.Add("client_assertion_type", clientAssertionType)
+	form.Add("client_assertion", assertion)
+	form.Add("scope", strings.Join(c.Scopes, " "))
+
+	return requestToken(http.DefaultClient, c.Domain, form)
+}
+
+// clientCredentialsTokenSource caches the token until shortly before it expires
+type clientCredentialsTokenSource struct {
+	mu     sync.Mutex
+	config *ClientCredentialsConfig
+	token  *Token
+}
+
+// TokenSource returns a source that caches tokens until they expire
+func (c *ClientCredentialsConfig) TokenSource() RefreshableTokenSource {
+	return &clientCredentialsTokenSource{config: c}
+}
+
+// Token returns the cached token, requesting a new one if it is about to expire
+func (s *clientCredentialsTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.token.ExpiresAt.IsZero() || time.Now().Add(tokenExpiryLeeway).Before(s.token.ExpiresAt)) {
+		return s.token, nil
+	}
+
+	return s.refresh()
+}
+
+// Refresh discards the cached token and requests a new one
+func (s *clientCredentialsTokenSource) Refresh() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh()
+}
+
+func (s *clientCredentialsTokenSource) refresh() (*Token, error) {
+	token, err := s.config.Token()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = token
+	return token, nil
+}
+
+// NewClientCredentialsClient creates new client that authorizes requests with tokens
+// obtained through the client_credentials grant
+func NewClientCredentialsClient(config *ClientCredentialsConfig) *CanvasClient {
+	return NewClientWithTokenSource(config.Domain, config.TokenSource())
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestClientCredentialsConfig_Assertion(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	config := &ClientCredentialsConfig{
+		Domain:     "domain",
+		ClientID:   "10000000000001",
+		KeyID:      "key-1",
+		PrivateKey: key,
+	}
+
+	assertion, err := config.Assertion(time.Minute)
+	assert.Nil(t, err)
+
+	parts := strings.Split(assertion, ".")
+	assert.Len(t, parts, 3)
+
+	header := map[string]string{}
+	b, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	assert.Nil(t, json.Unmarshal(b, &header))
+	assert.Equal(t, "RS256", header["alg"])
+	assert.Equal(t, "key-1", header["kid"])
+
+	claims := map[string]interface{}{}
+	b, _ = base64.RawURLEncoding.DecodeString(parts[1])
+	assert.Nil(t, json.Unmarshal(b, &claims))
+	assert.Equal(t, "10000000000001", claims["iss"])
+	assert.Equal(t, "10000000000001", claims["sub"])
+	assert.Equal(t, "https://domain.instructure.com/login/oauth2/token", claims["aud"])
+
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+}
+
+func TestClientCredentialsTokenSource_Token(t *testing.T) {
+	defer gock.Off()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	gock.New(domain).
+		Post("/login/oauth2/token").
+		BodyString("grant_type=client_credentials").
+		Reply(200).
+		JSON(map[string]interface{}{"access_token": "service", "expires_in": 3600})
+
+	config := &ClientCredentialsConfig{
+		Domain:     "domain",
+		ClientID:   "10000000000001",
+		PrivateKey: key,
+		Scopes:     []string{"https://purl.imsglobal.org/spec/lti-ags/scope/score"},
+	}
+	source := config.TokenSource()
+
+	got, err := source.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "service", got.AccessToken)
+
+	// the cached token is reused without another request
+	got, err = source.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "service", got.AccessToken)
+	assert.True(t, gock.IsDone())
+}
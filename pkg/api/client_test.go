@@ -1,10 +1,16 @@
 package api
 
 import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
 )
 
 func TestNewClient(t *testing.T) {
@@ -25,3 +31,104 @@ func TestCanvasClient_ClientURL(t *testing.T) {
 	got := NewClient("domain", "authToken").ClientURL()
 	assert.Equal(t, "https://domain.instructure.com", got)
 }
+
+func TestCanvasClient_getPaginatedJSON(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/planner_notes").
+		MatchParam("page", "2").
+		Reply(200).
+		JSON([]int64{3})
+
+	gock.New(domain).
+		Get("/api/v1/users/self/planner_notes").
+		Reply(200).
+		SetHeader("Link", `<https://domain.instructure.com/api/v1/users/self/planner_notes?page=1>; rel="current",`+
+			`<https://domain.instructure.com/api/v1/users/self/planner_notes?page=2>; rel="next"`).
+		JSON([]int64{1, 2})
+
+	got := make([]int64, 0)
+	err := client.getPaginatedJSON(domain+"/api/v1/users/self/planner_notes", &got)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, got)
+	assert.True(t, gock.IsDone())
+}
+
+// matchForm matches requests whose body is exactly the encoded form.
+// BodyString matches any body that contains its argument, and only its last call applies
+func matchForm(form url.Values) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		return string(body) == form.Encode(), nil
+	}
+}
+
+// matchMultipart matches multipart requests whose fields are exactly the form
+// and that upload a single file with the given field, name and content
+func matchMultipart(form url.Values, field string, name string, content string) gock.MatchFunc {
+	return func(req *http.Request, _ *gock.Request) (bool, error) {
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			return false, nil
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return false, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		got, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+		if err != nil {
+			return false, nil
+		}
+		defer got.RemoveAll()
+
+		files := got.File[field]
+		if len(got.File) != 1 || len(files) != 1 || files[0].Filename != name {
+			return false, nil
+		}
+
+		f, err := files[0].Open()
+		if err != nil {
+			return false, err
+		}
+		defer f.Close()
+
+		uploaded, err := ioutil.ReadAll(f)
+		if err != nil {
+			return false, err
+		}
+
+		return url.Values(got.Value).Encode() == form.Encode() && string(uploaded) == content, nil
+	}
+}
+
+func TestFormFields(t *testing.T) {
+	var create formFields
+	assert.True(t, create.has("title", true))
+	assert.False(t, create.has("rrule", false))
+
+	update, err := updateFields([]string{"title"})
+	assert.Nil(t, err)
+	assert.True(t, update.has("title", false))
+	assert.False(t, update.has("description", true))
+
+	assert.Nil(t, update.unknown())
+
+	_, err = updateFields(nil)
+	assert.EqualError(t, err, "no fields to update")
+
+	typo, err := updateFields([]string{"title", "titel", "dscription"})
+	assert.Nil(t, err)
+	assert.True(t, typo.has("title", false))
+	assert.False(t, typo.has("description", true))
+	assert.EqualError(t, typo.unknown(), `unknown fields to update: "dscription", "titel"`)
+}
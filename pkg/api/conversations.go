@@ -0,0 +1,423 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// InboxConversation is a conversation of the users inbox
+type InboxConversation struct {
+	ID      int64  `json:"id"`
+	Subject string `json:"subject"`
+	// WorkflowState is one of "read", "unread" or "archived"
+	WorkflowState         string                    `json:"workflow_state"`
+	LastMessage           string                    `json:"last_message"`
+	LastMessageAt         string                    `json:"last_message_at"`
+	LastAuthoredMessage   string                    `json:"last_authored_message"`
+	LastAuthoredMessageAt string                    `json:"last_authored_message_at"`
+	MessageCount          int64                     `json:"message_count"`
+	Subscribed            bool                      `json:"subscribed"`
+	Private               bool                      `json:"private"`
+	Starred               bool                      `json:"starred"`
+	Properties            []string                  `json:"properties"`
+	Audience              []int64                   `json:"audience"`
+	AvatarURL             string                    `json:"avatar_url"`
+	Participants          []ConversationParticipant `json:"participants"`
+	Visible               bool                      `json:"visible"`
+	ContextCode           string                    `json:"context_code"`
+	ContextName           string                    `json:"context_name"`
+	// Messages is only set when a single conversation is requested
+	Messages []ConversationMessage `json:"messages"`
+}
+
+// ConversationParticipant is a user taking part in a conversation
+type ConversationParticipant struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// ConversationMessage is a message of a conversation
+type ConversationMessage struct {
+	ID                   int64                 `json:"id"`
+	CreatedAt            string                `json:"created_at"`
+	Body                 string                `json:"body"`
+	AuthorID             int64                 `json:"author_id"`
+	Generated            bool                  `json:"generated"`
+	MediaComment         *MediaComment         `json:"media_comment"`
+	ForwardedMessages    []ConversationMessage `json:"forwarded_messages"`
+	Attachments          []File                `json:"attachments"`
+	ParticipatingUserIDs []int64               `json:"participating_user_ids"`
+}
+
+// MediaComment is an audio or video recording attached to a message
+type MediaComment struct {
+	MediaID     string `json:"media_id"`
+	MediaType   string `json:"media_type"`
+	DisplayName string `json:"display_name"`
+	ContentType string `json:"content-type"`
+	URL         string `json:"url"`
+}
+
+// ConversationDraft is a message to send to a new or an existing conversation
+type ConversationDraft struct {
+	// Recipients are user ids or contexts such as "course_123_students" or "group_456"
+	Recipients []string
+	Subject    string
+	Body       string
+	// GroupConversation sends a single conversation to all recipients instead of one per recipient
+	GroupConversation bool
+	// BulkMessage sends a private conversation to every recipient even when they already have one with the user
+	BulkMessage bool
+	ForceNew    bool
+	// AttachmentIDs are ids of files that were uploaded to the conversation attachments folder of the user
+	AttachmentIDs    []int64
+	MediaCommentID   string
+	MediaCommentType string
+	ContextCode      string
+	// IncludedMessages are ids of messages of the conversation to forward to new recipients
+	IncludedMessages []int64
+}
+
+// Recipient is a user or a context that messages can be sent to
+type Recipient struct {
+	// ID is a user id or a context such as "course_123"
+	ID        string `json:"-"`
+	Name      string `json:"name"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url"`
+	// Type is "user" or "context"
+	Type          string              `json:"type"`
+	UserCount     int64               `json:"user_count"`
+	ItemCount     int64               `json:"item_count"`
+	CommonCourses map[string][]string `json:"common_courses"`
+	CommonGroups  map[string][]string `json:"common_groups"`
+}
+
+// UnmarshalJSON reads the id of the recipient, which canvas sends as a number for users and as a string for contexts
+func (r *Recipient) UnmarshalJSON(data []byte) error {
+	type recipient Recipient
+	aux := struct {
+		*recipient
+		ID json.RawMessage `json:"id"`
+	}{recipient: (*recipient)(r)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.ID = ""
+	if len(aux.ID) == 0 {
+		return nil
+	}
+
+	// null leaves the id empty
+	if err := json.Unmarshal(aux.ID, &r.ID); err == nil {
+		return nil
+	}
+
+	var id json.Number
+	if err := json.Unmarshal(aux.ID, &id); err != nil {
+		return err
+	}
+	r.ID = id.String()
+
+	return nil
+}
+
+// ConversationsOptions is an interface for the lookup of conversations
+type ConversationsOptions struct {
+	scope  string
+	filter []string
+	err    []error
+}
+
+// ConversationsOption is an adapter for generating options
+type ConversationsOption func(*ConversationsOptions)
+
+// WithConversationScope limits the conversations to the scope
+// Scope can only be one of: {"unread" | "starred" | "archived" | "sent"}
+func WithConversationScope(scope string) ConversationsOption {
+	if scope != "unread" && scope != "starred" && scope != "archived" && scope != "sent" {
+		return func(co *ConversationsOptions) {
+			co.err = append(co.err, errors.New("keyword scope can be only one of: 'unread' | 'starred' | 'archived' | 'sent'"))
+		}
+	}
+	return func(co *ConversationsOptions) {
+		co.scope = scope
+	}
+}
+
+// WithConversationFilter limits the conversations to those with the contexts or users, such as "course_123" or "user_456"
+func WithConversationFilter(filter ...string) ConversationsOption {
+	return func(co *ConversationsOptions) {
+		co.filter = append(co.filter, filter...)
+	}
+}
+
+// RecipientsOptions is an interface for the lookup of recipients
+type RecipientsOptions struct {
+	context       string
+	recipientType string
+	exclude       []string
+	err           []error
+}
+
+// RecipientsOption is an adapter for generating options
+type RecipientsOption func(*RecipientsOptions)
+
+// WithRecipientContext limits the recipients to the context, such as "course_123" or "course_123_students"
+func WithRecipientContext(context string) RecipientsOption {
+	return func(ro *RecipientsOptions) {
+		ro.context = context
+	}
+}
+
+// WithRecipientType limits the recipients to users or contexts
+// Type can only be one of: {"user" | "context"}
+func WithRecipientType(recipientType string) RecipientsOption {
+	if recipientType != "user" && recipientType != "context" {
+		return func(ro *RecipientsOptions) {
+			ro.err = append(ro.err, errors.New("keyword type can be only one of: 'user' | 'context'"))
+		}
+	}
+	return func(ro *RecipientsOptions) {
+		ro.recipientType = recipientType
+	}
+}
+
+// WithRecipientExclusions leaves out the users or contexts
+func WithRecipientExclusions(exclude ...string) RecipientsOption {
+	return func(ro *RecipientsOptions) {
+		ro.exclude = append(ro.exclude, exclude...)
+	}
+}
+
+// GetInboxConversations returns the conversations of the user, without their messages
+func (c *CanvasClient) GetInboxConversations(setters ...ConversationsOption) ([]InboxConversation, error) {
+	args := &ConversationsOptions{}
+	conversations := make([]InboxConversation, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return conversations, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/conversations", c.ClientURL()))
+
+	if err != nil {
+		return conversations, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.scope != "" {
+		q.Add("scope", args.scope)
+	}
+	for _, filter := range args.filter {
+		q.Add("filter[]", filter)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &conversations)
+
+	if err != nil {
+		return conversations, err
+	}
+
+	return conversations, nil
+}
+
+// GetInboxConversation returns the conversation with the given conversationID with all of its messages.
+// Unless markRead is set, the conversation keeps its read state
+func (c *CanvasClient) GetInboxConversation(conversationID int64, markRead bool) (*InboxConversation, error) {
+	conversation := InboxConversation{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations/%d?auto_mark_as_read=%t", c.ClientURL(), conversationID, markRead)
+	err := c.getJSON(requestURL, &conversation)
+
+	if err != nil {
+		return &conversation, err
+	}
+
+	return &conversation, nil
+}
+
+// CreateInboxConversation sends the draft and returns the conversations it created,
+// one per recipient unless GroupConversation is set
+func (c *CanvasClient) CreateInboxConversation(draft *ConversationDraft) ([]InboxConversation, error) {
+	created := make([]InboxConversation, 0)
+
+	form := conversationDraftForm(draft)
+	form.Add("subject", draft.Subject)
+	if draft.GroupConversation {
+		form.Add("group_conversation", "true")
+	}
+	if draft.BulkMessage {
+		form.Add("bulk_message", "true")
+	}
+	if draft.ForceNew {
+		form.Add("force_new", "true")
+	}
+	if draft.ContextCode != "" {
+		form.Add("context_code", draft.ContextCode)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations", c.ClientURL())
+	err := c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+// AddConversationMessage sends the body, attachments and media comment of the draft to the conversation.
+// Recipients of the draft limit the message to those participants
+func (c *CanvasClient) AddConversationMessage(conversationID int64, draft *ConversationDraft) (*InboxConversation, error) {
+	conversation := InboxConversation{}
+
+	form := conversationDraftForm(draft)
+	for _, id := range draft.IncludedMessages {
+		form.Add("included_messages[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations/%d/add_message", c.ClientURL(), conversationID)
+	err := c.sendJSON("POST", requestURL, form, &conversation)
+
+	if err != nil {
+		return &conversation, err
+	}
+
+	return &conversation, nil
+}
+
+// AddConversationRecipients adds the users or contexts to the conversation
+func (c *CanvasClient) AddConversationRecipients(conversationID int64, recipients ...string) (*InboxConversation, error) {
+	conversation := InboxConversation{}
+
+	form := url.Values{}
+	for _, recipient := range recipients {
+		form.Add("recipients[]", recipient)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations/%d/add_recipients", c.ClientURL(), conversationID)
+	err := c.sendJSON("POST", requestURL, form, &conversation)
+
+	if err != nil {
+		return &conversation, err
+	}
+
+	return &conversation, nil
+}
+
+func conversationDraftForm(draft *ConversationDraft) url.Values {
+	form := url.Values{}
+	form.Add("body", draft.Body)
+	for _, recipient := range draft.Recipients {
+		form.Add("recipients[]", recipient)
+	}
+	for _, id := range draft.AttachmentIDs {
+		form.Add("attachment_ids[]", strconv.FormatInt(id, 10))
+	}
+	if draft.MediaCommentID != "" {
+		form.Add("media_comment_id", draft.MediaCommentID)
+		form.Add("media_comment_type", draft.MediaCommentType)
+	}
+
+	return form
+}
+
+// BatchUpdateConversations applies the event to the conversations in the background
+// Event can only be one of: {"mark_as_read" | "mark_as_unread" | "star" | "unstar" | "archive" | "destroy"}
+func (c *CanvasClient) BatchUpdateConversations(conversationIDs []int64, event string) (*Progress, error) {
+	p := Progress{}
+
+	switch event {
+	case "mark_as_read", "mark_as_unread", "star", "unstar", "archive", "destroy":
+	default:
+		return &p, errors.New("keyword event can be only one of: 'mark_as_read' | 'mark_as_unread' | 'star' | 'unstar' | 'archive' | 'destroy'")
+	}
+
+	form := url.Values{}
+	form.Add("event", event)
+	for _, id := range conversationIDs {
+		form.Add("conversation_ids[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations", c.ClientURL())
+	err := c.sendJSON("PUT", requestURL, form, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
+
+// GetUnreadConversationCount returns the number of unread conversations of the user
+func (c *CanvasClient) GetUnreadConversationCount() (int64, error) {
+	count := struct {
+		// UnreadCount is sent as a string by canvas
+		UnreadCount json.Number `json:"unread_count"`
+	}{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/conversations/unread_count", c.ClientURL())
+	err := c.getJSON(requestURL, &count)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count.UnreadCount.Int64()
+}
+
+// SearchRecipients returns the users and contexts the user can send messages to whose names match search
+func (c *CanvasClient) SearchRecipients(search string, setters ...RecipientsOption) ([]Recipient, error) {
+	args := &RecipientsOptions{}
+	recipients := make([]Recipient, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return recipients, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/search/recipients", c.ClientURL()))
+
+	if err != nil {
+		return recipients, err
+	}
+
+	q := parsedURL.Query()
+
+	q.Add("search", search)
+	if args.context != "" {
+		q.Add("context", args.context)
+	}
+	if args.recipientType != "" {
+		q.Add("type", args.recipientType)
+	}
+	for _, exclude := range args.exclude {
+		q.Add("exclude[]", exclude)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &recipients)
+
+	if err != nil {
+		return recipients, err
+	}
+
+	return recipients, nil
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateInboxConversation(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/conversations").
+		AddMatcher(matchForm(url.Values{
+			"body":             {"Lab is tomorrow"},
+			"recipients[]":     {"course_5_students"},
+			"attachment_ids[]": {"9"},
+			"subject":          {"Reminder"},
+			"bulk_message":     {"true"},
+		})).
+		Reply(201).
+		JSON([]InboxConversation{{ID: 1, Subject: "Reminder"}, {ID: 2, Subject: "Reminder"}})
+
+	got, err := client.CreateInboxConversation(&ConversationDraft{
+		Recipients:    []string{"course_5_students"},
+		Subject:       "Reminder",
+		Body:          "Lab is tomorrow",
+		BulkMessage:   true,
+		AttachmentIDs: []int64{9},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestCanvasClient_GetInboxConversation(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/conversations/1").
+		MatchParam("auto_mark_as_read", "false").
+		Reply(200).
+		BodyString(`{"id": 1, "workflow_state": "unread", "participants": [{"id": 8, "name": "Ada"}],
+			"messages": [{"id": 3, "author_id": 8, "body": "hi",
+				"media_comment": {"media_id": "m-1", "media_type": "audio"},
+				"forwarded_messages": [{"id": 2, "body": "earlier"}]}]}`)
+
+	got, err := client.GetInboxConversation(1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "unread", got.WorkflowState)
+	assert.Equal(t, "audio", got.Messages[0].MediaComment.MediaType)
+	assert.Equal(t, "earlier", got.Messages[0].ForwardedMessages[0].Body)
+}
+
+func TestCanvasClient_BatchUpdateConversations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/conversations").
+		BodyString("conversation_ids%5B%5D=1&conversation_ids%5B%5D=2&event=star").
+		Reply(200).
+		JSON(Progress{ID: 7, Tag: "conversation_batch_update", WorkflowState: "queued"})
+
+	got, err := client.BatchUpdateConversations([]int64{1, 2}, "star")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), got.ID)
+	assert.False(t, got.Done())
+
+	_, err = client.BatchUpdateConversations([]int64{1}, "flag")
+	assert.NotNil(t, err)
+}
+
+func TestCanvasClient_GetUnreadConversationCount(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/conversations/unread_count").
+		Reply(200).
+		BodyString(`{"unread_count": "7"}`)
+
+	got, err := client.GetUnreadConversationCount()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), got)
+}
+
+func TestCanvasClient_SearchRecipients(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/search/recipients").
+		MatchParam("search", "bio").
+		MatchParam("type", "context").
+		Reply(200).
+		BodyString(`[{"id": "course_5", "name": "Biology", "type": "context", "user_count": 30},
+			{"id": 8, "name": "Ada", "type": "user", "common_courses": {"5": ["StudentEnrollment"]}}]`)
+
+	got, err := client.SearchRecipients("bio", WithRecipientType("context"))
+	assert.Nil(t, err)
+	assert.Equal(t, "course_5", got[0].ID)
+	assert.Equal(t, int64(30), got[0].UserCount)
+	assert.Equal(t, "8", got[1].ID)
+	assert.Equal(t, []string{"StudentEnrollment"}, got[1].CommonCourses["5"])
+}
+
+func TestRecipient_UnmarshalJSON(t *testing.T) {
+	var got []Recipient
+	err := json.Unmarshal([]byte(`[{"id": "group_3"}, {"id": 12345678901234567890}, {"id": null}, {}]`), &got)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"group_3", "12345678901234567890", "", ""}, []string{got[0].ID, got[1].ID, got[2].ID, got[3].ID})
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"id": true}`), &Recipient{}))
+}
@@ -0,0 +1,35 @@
+package api
+
+// Course is a canvas course
+type Course struct {
+	ID                int64  `json:"id"`
+	SisCourseID       string `json:"sis_course_id"`
+	UUID              string `json:"uuid"`
+	Name              string `json:"name"`
+	CourseCode        string `json:"course_code"`
+	OriginalName      string `json:"original_name"`
+	WorkflowState     string `json:"workflow_state"`
+	AccountID         int64  `json:"account_id"`
+	RootAccountID     int64  `json:"root_account_id"`
+	EnrollmentTermID  int64  `json:"enrollment_term_id"`
+	GradingStandardID int64  `json:"grading_standard_id"`
+	CreatedAt         string `json:"created_at"`
+	StartAt           string `json:"start_at"`
+	EndAt             string `json:"end_at"`
+	Locale            string `json:"locale"`
+	TimeZone          string `json:"time_zone"`
+	DefaultView       string `json:"default_view"`
+	IsPublic          bool   `json:"is_public"`
+	CourseFormat      string `json:"course_format"`
+}
+
+// Section is a section of a course
+type Section struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	CourseID      int64  `json:"course_id"`
+	SisSectionID  string `json:"sis_section_id"`
+	StartAt       string `json:"start_at"`
+	EndAt         string `json:"end_at"`
+	TotalStudents int64  `json:"total_students"`
+}
@@ -0,0 +1,481 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Discussion is a discussion topic of a course
+type Discussion struct {
+	ID                      int64                  `json:"id"`
+	Title                   string                 `json:"title"`
+	Message                 string                 `json:"message"`
+	HTMLURL                 string                 `json:"html_url"`
+	PostedAt                string                 `json:"posted_at"`
+	LastReplyAt             string                 `json:"last_reply_at"`
+	DelayedPostAt           string                 `json:"delayed_post_at"`
+	LockAt                  string                 `json:"lock_at"`
+	RequireInitialPost      bool                   `json:"require_initial_post"`
+	UserCanSeePosts         bool                   `json:"user_can_see_posts"`
+	DiscussionSubentryCount int64                  `json:"discussion_subentry_count"`
+	ReadState               string                 `json:"read_state"`
+	UnreadCount             int64                  `json:"unread_count"`
+	Subscribed              bool                   `json:"subscribed"`
+	SubscriptionHold        string                 `json:"subscription_hold"`
+	AssignmentID            int64                  `json:"assignment_id"`
+	Assignment              *Assignment            `json:"assignment"`
+	Published               bool                   `json:"published"`
+	Locked                  bool                   `json:"locked"`
+	Pinned                  bool                   `json:"pinned"`
+	Position                int64                  `json:"position"`
+	LockedForUser           bool                   `json:"locked_for_user"`
+	LockExplanation         string                 `json:"lock_explanation"`
+	UserName                string                 `json:"user_name"`
+	Author                  *DiscussionParticipant `json:"author"`
+	TopicChildren           []int64                `json:"topic_children"`
+	GroupTopicChildren      []GroupTopicChild      `json:"group_topic_children"`
+	RootTopicID             int64                  `json:"root_topic_id"`
+	PodcastEnabled          bool                   `json:"podcast_enabled"`
+	PodcastURL              string                 `json:"podcast_url"`
+	// DiscussionType is "side_comment" for flat discussions and "threaded" for threaded ones
+	DiscussionType     string          `json:"discussion_type"`
+	GroupCategoryID    int64           `json:"group_category_id"`
+	IsAnnouncement     bool            `json:"is_announcement"`
+	ContextCode        string          `json:"context_code"`
+	IsSectionSpecific  bool            `json:"is_section_specific"`
+	Sections           []Section       `json:"sections"`
+	Attachments        []File          `json:"attachments"`
+	Permissions        map[string]bool `json:"permissions"`
+	AllowRating        bool            `json:"allow_rating"`
+	OnlyGradersCanRate bool            `json:"only_graders_can_rate"`
+	SortByRating       bool            `json:"sort_by_rating"`
+}
+
+// GroupTopicChild is the copy of a group discussion in one of the groups of its category
+type GroupTopicChild struct {
+	ID      int64 `json:"id"`
+	GroupID int64 `json:"group_id"`
+}
+
+// DiscussionEntry is an entry or a reply of a discussion
+type DiscussionEntry struct {
+	ID              int64                `json:"id"`
+	UserID          int64                `json:"user_id"`
+	EditorID        int64                `json:"editor_id"`
+	UserName        string               `json:"user_name"`
+	User            *DiscussionEntryUser `json:"user"`
+	ParentID        int64                `json:"parent_id"`
+	Message         string               `json:"message"`
+	ReadState       string               `json:"read_state"`
+	ForcedReadState bool                 `json:"forced_read_state"`
+	CreatedAt       string               `json:"created_at"`
+	UpdatedAt       string               `json:"updated_at"`
+	Deleted         bool                 `json:"deleted"`
+	RatingCount     int64                `json:"rating_count"`
+	RatingSum       int64                `json:"rating_sum"`
+	Attachment      *File                `json:"attachment"`
+	RecentReplies   []DiscussionEntry    `json:"recent_replies"`
+	HasMoreReplies  bool                 `json:"has_more_replies"`
+	// Replies is only set on entries of the full view of a discussion
+	Replies []DiscussionEntry `json:"replies"`
+}
+
+// DiscussionEntryUser is the author of an entry as it is shown in the activity stream
+type DiscussionEntryUser struct {
+	UserID   int64  `json:"user_id"`
+	UserName string `json:"user_name"`
+}
+
+// DiscussionParticipant is a user taking part in a discussion
+type DiscussionParticipant struct {
+	ID             int64  `json:"id"`
+	DisplayName    string `json:"display_name"`
+	AvatarImageURL string `json:"avatar_image_url"`
+	HTMLURL        string `json:"html_url"`
+	Pronouns       string `json:"pronouns"`
+}
+
+// DiscussionView is the full discussion with its entries as a tree of replies
+type DiscussionView struct {
+	UnreadEntries []int64                 `json:"unread_entries"`
+	ForcedEntries []int64                 `json:"forced_entries"`
+	EntryRatings  map[string]int64        `json:"entry_ratings"`
+	Participants  []DiscussionParticipant `json:"participants"`
+	View          []DiscussionEntry       `json:"view"`
+	NewEntries    []DiscussionEntry       `json:"new_entries"`
+}
+
+// Participant returns the participant with the given userID or nil if they did not take part
+func (v *DiscussionView) Participant(userID int64) *DiscussionParticipant {
+	for i := range v.Participants {
+		if v.Participants[i].ID == userID {
+			return &v.Participants[i]
+		}
+	}
+
+	return nil
+}
+
+// DiscussionsOptions is an interface for the lookup of discussions
+type DiscussionsOptions struct {
+	orderBy    string
+	scope      string
+	searchTerm string
+	unread     bool
+	err        []error
+}
+
+// DiscussionsOption is an adapter for generating options
+type DiscussionsOption func(*DiscussionsOptions)
+
+// WithDiscussionOrder orders the discussions
+// Order can only be one of: {"position" | "recent_activity" | "title"}
+func WithDiscussionOrder(orderBy string) DiscussionsOption {
+	if orderBy != "position" && orderBy != "recent_activity" && orderBy != "title" {
+		return func(do *DiscussionsOptions) {
+			do.err = append(do.err, errors.New("keyword order can be only one of: 'position' | 'recent_activity' | 'title'"))
+		}
+	}
+	return func(do *DiscussionsOptions) {
+		do.orderBy = orderBy
+	}
+}
+
+// WithDiscussionScope limits the discussions to the scope
+// Scope can only be one of: {"locked" | "unlocked" | "pinned" | "unpinned"}
+func WithDiscussionScope(scope string) DiscussionsOption {
+	if scope != "locked" && scope != "unlocked" && scope != "pinned" && scope != "unpinned" {
+		return func(do *DiscussionsOptions) {
+			do.err = append(do.err, errors.New("keyword scope can be only one of: 'locked' | 'unlocked' | 'pinned' | 'unpinned'"))
+		}
+	}
+	return func(do *DiscussionsOptions) {
+		do.scope = scope
+	}
+}
+
+// WithDiscussionSearchTerm limits the discussions to those whose title matches the search term
+func WithDiscussionSearchTerm(searchTerm string) DiscussionsOption {
+	return func(do *DiscussionsOptions) {
+		do.searchTerm = searchTerm
+	}
+}
+
+// WithUnreadDiscussions limits the discussions to those with unread entries
+func WithUnreadDiscussions() DiscussionsOption {
+	return func(do *DiscussionsOptions) {
+		do.unread = true
+	}
+}
+
+// GetDiscussionTopics returns the discussions of a course
+func (c *CanvasClient) GetDiscussionTopics(courseID int64, setters ...DiscussionsOption) ([]Discussion, error) {
+	args := &DiscussionsOptions{}
+	d := make([]Discussion, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return d, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(c.discussionURL(courseID, ""))
+
+	if err != nil {
+		return d, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.orderBy != "" {
+		q.Add("order_by", args.orderBy)
+	}
+	if args.scope != "" {
+		q.Add("scope", args.scope)
+	}
+	if args.searchTerm != "" {
+		q.Add("search_term", args.searchTerm)
+	}
+	if args.unread {
+		q.Add("filter_by", "unread")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &d)
+
+	if err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+// GetDiscussionTopic returns the discussion with the given topicID
+func (c *CanvasClient) GetDiscussionTopic(courseID int64, topicID int64) (*Discussion, error) {
+	d := Discussion{}
+
+	err := c.getJSON(c.discussionURL(courseID, "/%d", topicID), &d)
+
+	if err != nil {
+		return &d, err
+	}
+
+	return &d, nil
+}
+
+// CreateDiscussionTopic creates a discussion in the course.
+// Setting Assignment makes the discussion graded, setting GroupCategoryID makes it a group discussion.
+// A topic that is not Published is created as a draft
+func (c *CanvasClient) CreateDiscussionTopic(courseID int64, topic *Discussion) (*Discussion, error) {
+	created := Discussion{}
+
+	form, err := discussionForm(topic, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	err = c.sendJSON("POST", c.discussionURL(courseID, ""), form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateDiscussionTopic updates the named fields of the discussion, such as "title" or "published", to match the given one
+func (c *CanvasClient) UpdateDiscussionTopic(courseID int64, topic *Discussion, fields ...string) (*Discussion, error) {
+	updated := Discussion{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := discussionForm(topic, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	err = c.sendJSON("PUT", c.discussionURL(courseID, "/%d", topic.ID), form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteDiscussionTopic deletes the discussion with the given topicID with all of its entries
+func (c *CanvasClient) DeleteDiscussionTopic(courseID int64, topicID int64) error {
+	return c.sendJSON("DELETE", c.discussionURL(courseID, "/%d", topicID), nil, nil)
+}
+
+func discussionForm(topic *Discussion, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("title", topic.Title)
+	}
+	if fields.has("message", true) {
+		form.Add("message", topic.Message)
+	}
+	if fields.has("discussion_type", topic.DiscussionType != "") {
+		form.Add("discussion_type", topic.DiscussionType)
+	}
+	if fields.has("published", true) {
+		form.Add("published", strconv.FormatBool(topic.Published))
+	}
+	if fields.has("pinned", true) {
+		form.Add("pinned", strconv.FormatBool(topic.Pinned))
+	}
+	if fields.has("locked", true) {
+		form.Add("locked", strconv.FormatBool(topic.Locked))
+	}
+	if fields.has("require_initial_post", true) {
+		form.Add("require_initial_post", strconv.FormatBool(topic.RequireInitialPost))
+	}
+	if fields.has("podcast_enabled", true) {
+		form.Add("podcast_enabled", strconv.FormatBool(topic.PodcastEnabled))
+	}
+	if fields.has("allow_rating", true) {
+		form.Add("allow_rating", strconv.FormatBool(topic.AllowRating))
+	}
+	if fields.has("only_graders_can_rate", true) {
+		form.Add("only_graders_can_rate", strconv.FormatBool(topic.OnlyGradersCanRate))
+	}
+	if fields.has("sort_by_rating", true) {
+		form.Add("sort_by_rating", strconv.FormatBool(topic.SortByRating))
+	}
+	if fields.has("is_announcement", topic.IsAnnouncement) {
+		form.Add("is_announcement", strconv.FormatBool(topic.IsAnnouncement))
+	}
+	if fields.has("delayed_post_at", topic.DelayedPostAt != "") {
+		form.Add("delayed_post_at", topic.DelayedPostAt)
+	}
+	if fields.has("lock_at", topic.LockAt != "") {
+		form.Add("lock_at", topic.LockAt)
+	}
+	if fields.has("specific_sections", len(topic.Sections) != 0) {
+		ids := make([]string, 0, len(topic.Sections))
+		for _, section := range topic.Sections {
+			ids = append(ids, strconv.FormatInt(section.ID, 10))
+		}
+		form.Add("specific_sections", strings.Join(ids, ","))
+	}
+	if fields.has("position", topic.Position != 0) {
+		form.Add("position", strconv.FormatInt(topic.Position, 10))
+	}
+	if fields.has("group_category_id", topic.GroupCategoryID != 0) {
+		form.Add("group_category_id", strconv.FormatInt(topic.GroupCategoryID, 10))
+	}
+	if a := topic.Assignment; fields.has("assignment", true) && a != nil {
+		form.Add("assignment[points_possible]", strconv.FormatFloat(a.PointsPossible, 'f', -1, 64))
+		if a.GradingType != "" {
+			form.Add("assignment[grading_type]", a.GradingType)
+		}
+		if a.DueAt != "" {
+			form.Add("assignment[due_at]", a.DueAt)
+		}
+		if a.AssignmentGroupID != 0 {
+			form.Add("assignment[assignment_group_id]", strconv.FormatInt(a.AssignmentGroupID, 10))
+		}
+	}
+
+	return form, fields.unknown()
+}
+
+// GetDiscussionEntries returns the top level entries of the discussion
+func (c *CanvasClient) GetDiscussionEntries(courseID int64, topicID int64) ([]DiscussionEntry, error) {
+	return c.getDiscussionEntries(c.discussionURL(courseID, "/%d/entries", topicID))
+}
+
+// GetDiscussionReplies returns the replies to the entry
+func (c *CanvasClient) GetDiscussionReplies(courseID int64, topicID int64, entryID int64) ([]DiscussionEntry, error) {
+	return c.getDiscussionEntries(c.discussionURL(courseID, "/%d/entries/%d/replies", topicID, entryID))
+}
+
+func (c *CanvasClient) getDiscussionEntries(requestURL string) ([]DiscussionEntry, error) {
+	e := make([]DiscussionEntry, 0)
+
+	err := c.getPaginatedJSON(requestURL, &e)
+
+	if err != nil {
+		return e, err
+	}
+
+	return e, nil
+}
+
+// PostDiscussionEntry posts a top level entry to the discussion.
+// A nil attachment posts the message alone
+func (c *CanvasClient) PostDiscussionEntry(courseID int64, topicID int64, message string, attachment *FileUpload) (*DiscussionEntry, error) {
+	return c.postDiscussionEntry(c.discussionURL(courseID, "/%d/entries", topicID), message, attachment)
+}
+
+// PostDiscussionReply posts a reply to the entry.
+// A nil attachment posts the message alone
+func (c *CanvasClient) PostDiscussionReply(courseID int64, topicID int64, entryID int64, message string, attachment *FileUpload) (*DiscussionEntry, error) {
+	return c.postDiscussionEntry(c.discussionURL(courseID, "/%d/entries/%d/replies", topicID, entryID), message, attachment)
+}
+
+func (c *CanvasClient) postDiscussionEntry(requestURL string, message string, attachment *FileUpload) (*DiscussionEntry, error) {
+	created := DiscussionEntry{}
+
+	form := url.Values{}
+	form.Add("message", message)
+
+	err := c.sendMultipart("POST", requestURL, form, "attachment", attachment, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateDiscussionEntry replaces the message of the entry
+func (c *CanvasClient) UpdateDiscussionEntry(courseID int64, topicID int64, entryID int64, message string) (*DiscussionEntry, error) {
+	updated := DiscussionEntry{}
+
+	form := url.Values{}
+	form.Add("message", message)
+
+	err := c.sendJSON("PUT", c.discussionURL(courseID, "/%d/entries/%d", topicID, entryID), form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteDiscussionEntry deletes the entry, its replies are kept
+func (c *CanvasClient) DeleteDiscussionEntry(courseID int64, topicID int64, entryID int64) error {
+	return c.sendJSON("DELETE", c.discussionURL(courseID, "/%d/entries/%d", topicID, entryID), nil, nil)
+}
+
+// RateDiscussionEntry likes or unlikes the entry
+func (c *CanvasClient) RateDiscussionEntry(courseID int64, topicID int64, entryID int64, liked bool) error {
+	form := url.Values{}
+	if liked {
+		form.Add("rating", "1")
+	} else {
+		form.Add("rating", "0")
+	}
+
+	return c.sendJSON("POST", c.discussionURL(courseID, "/%d/entries/%d/rating", topicID, entryID), form, nil)
+}
+
+// MarkDiscussionTopicRead marks the discussion itself as read or unread
+func (c *CanvasClient) MarkDiscussionTopicRead(courseID int64, topicID int64, read bool) error {
+	return c.sendJSON(readMethod(read), c.discussionURL(courseID, "/%d/read", topicID), nil, nil)
+}
+
+// MarkAllDiscussionEntriesRead marks the discussion and all of its entries as read or unread
+func (c *CanvasClient) MarkAllDiscussionEntriesRead(courseID int64, topicID int64, read bool) error {
+	return c.sendJSON(readMethod(read), c.discussionURL(courseID, "/%d/read_all", topicID), nil, nil)
+}
+
+// MarkDiscussionEntryRead marks the entry as read or unread
+func (c *CanvasClient) MarkDiscussionEntryRead(courseID int64, topicID int64, entryID int64, read bool) error {
+	return c.sendJSON(readMethod(read), c.discussionURL(courseID, "/%d/entries/%d/read", topicID, entryID), nil, nil)
+}
+
+// SubscribeDiscussionTopic subscribes to or unsubscribes from notifications about the discussion
+func (c *CanvasClient) SubscribeDiscussionTopic(courseID int64, topicID int64, subscribed bool) error {
+	return c.sendJSON(readMethod(subscribed), c.discussionURL(courseID, "/%d/subscribed", topicID), nil, nil)
+}
+
+// GetDiscussionView returns the full discussion with its entries as a tree of replies and its participants
+func (c *CanvasClient) GetDiscussionView(courseID int64, topicID int64) (*DiscussionView, error) {
+	v := DiscussionView{}
+
+	err := c.getJSON(c.discussionURL(courseID, "/%d/view", topicID), &v)
+
+	if err != nil {
+		return &v, err
+	}
+
+	return &v, nil
+}
+
+// discussionURL returns the URL of the discussions of the course followed by the formatted path
+func (c *CanvasClient) discussionURL(courseID int64, path string, args ...interface{}) string {
+	return fmt.Sprintf("%s/api/v1/courses/%d/discussion_topics", c.ClientURL(), courseID) + fmt.Sprintf(path, args...)
+}
+
+// readMethod returns the method that sets a state, such as read or subscribed, of a discussion
+func readMethod(set bool) string {
+	if set {
+		return "PUT"
+	}
+	return "DELETE"
+}
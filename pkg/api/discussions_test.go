@@ -0,0 +1,170 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetDiscussionTopics(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/discussion_topics").
+		MatchParam("scope", "pinned").
+		MatchParam("filter_by", "unread").
+		Reply(200).
+		BodyString(`[{"id": 12, "title": "Week 1", "pinned": true, "discussion_type": "threaded",
+			"group_topic_children": [{"id": 13, "group_id": 4}]}]`)
+
+	got, err := client.GetDiscussionTopics(5, WithDiscussionScope("pinned"), WithUnreadDiscussions())
+	assert.Nil(t, err)
+	assert.Equal(t, []Discussion{{
+		ID: 12, Title: "Week 1", Pinned: true, DiscussionType: "threaded",
+		GroupTopicChildren: []GroupTopicChild{{ID: 13, GroupID: 4}},
+	}}, got)
+
+	_, err = client.GetDiscussionTopics(5, WithDiscussionScope("hidden"))
+	assert.NotNil(t, err)
+}
+
+func TestCanvasClient_CreateDiscussionTopic(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/discussion_topics").
+		AddMatcher(matchForm(url.Values{
+			"title":                       {"Graded"},
+			"message":                     {""},
+			"discussion_type":             {"threaded"},
+			"require_initial_post":        {"false"},
+			"podcast_enabled":             {"false"},
+			"allow_rating":                {"false"},
+			"only_graders_can_rate":       {"false"},
+			"sort_by_rating":              {"false"},
+			"published":                   {"true"},
+			"pinned":                      {"false"},
+			"locked":                      {"false"},
+			"group_category_id":           {"3"},
+			"assignment[points_possible]": {"10"},
+		})).
+		Reply(200).
+		JSON(Discussion{ID: 12, Title: "Graded", AssignmentID: 40, GroupCategoryID: 3})
+
+	got, err := client.CreateDiscussionTopic(5, &Discussion{
+		Title:           "Graded",
+		DiscussionType:  "threaded",
+		Published:       true,
+		GroupCategoryID: 3,
+		Assignment:      &Assignment{PointsPossible: 10},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(40), got.AssignmentID)
+}
+
+func TestCanvasClient_CreateDiscussionTopicDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/discussion_topics").
+		AddMatcher(matchForm(url.Values{
+			"title":                 {"Draft"},
+			"message":               {""},
+			"require_initial_post":  {"false"},
+			"podcast_enabled":       {"false"},
+			"allow_rating":          {"false"},
+			"only_graders_can_rate": {"false"},
+			"sort_by_rating":        {"false"},
+			"published":             {"false"},
+			"pinned":                {"false"},
+			"locked":                {"false"},
+		})).
+		Reply(200).
+		JSON(Discussion{ID: 13, Title: "Draft"})
+
+	got, err := client.CreateDiscussionTopic(5, &Discussion{Title: "Draft"})
+	assert.Nil(t, err)
+	assert.False(t, got.Published)
+}
+
+func TestCanvasClient_UpdateDiscussionTopic(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/discussion_topics/12").
+		AddMatcher(matchForm(url.Values{"published": {"false"}})).
+		Reply(200).
+		JSON(Discussion{ID: 12, Title: "Graded"})
+
+	got, err := client.UpdateDiscussionTopic(5, &Discussion{ID: 12}, "published")
+	assert.Nil(t, err)
+	assert.False(t, got.Published)
+
+	_, err = client.UpdateDiscussionTopic(5, &Discussion{ID: 12})
+	assert.EqualError(t, err, "no fields to update")
+
+	_, err = client.UpdateDiscussionTopic(5, &Discussion{ID: 12}, "publish")
+	assert.EqualError(t, err, `unknown fields to update: "publish"`)
+}
+
+func TestCanvasClient_PostDiscussionReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/discussion_topics/12/entries/20/replies").
+		AddMatcher(matchMultipart(url.Values{"message": {"see attached"}}, "attachment", "notes.txt", "my notes")).
+		Reply(201).
+		JSON(DiscussionEntry{ID: 21, ParentID: 20, Message: "see attached", Attachment: &File{ID: 9, DisplayName: "notes.txt"}})
+
+	got, err := client.PostDiscussionReply(5, 12, 20, "see attached", &FileUpload{Name: "notes.txt", Content: strings.NewReader("my notes")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), got.ParentID)
+	assert.Equal(t, "notes.txt", got.Attachment.DisplayName)
+}
+
+func TestCanvasClient_MarkDiscussionEntryRead(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/api/v1/courses/5/discussion_topics/12/entries/20/read").
+		Reply(204)
+
+	err := client.MarkDiscussionEntryRead(5, 12, 20, false)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+func TestCanvasClient_GetDiscussionView(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/discussion_topics/12/view").
+		Reply(200).
+		BodyString(`{
+			"unread_entries": [2],
+			"forced_entries": [],
+			"entry_ratings": {"2": 1},
+			"participants": [{"id": 8, "display_name": "Ada"}, {"id": 9, "display_name": "Grace"}],
+			"view": [{"id": 1, "user_id": 8, "message": "top", "replies": [
+				{"id": 2, "user_id": 9, "parent_id": 1, "message": "reply", "replies": [
+					{"id": 3, "user_id": 8, "parent_id": 2, "deleted": true}
+				]}
+			]}],
+			"new_entries": []
+		}`)
+
+	got, err := client.GetDiscussionView(5, 12)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2}, got.UnreadEntries)
+	assert.Equal(t, int64(1), got.EntryRatings["2"])
+
+	reply := got.View[0].Replies[0]
+	assert.Equal(t, "reply", reply.Message)
+	assert.True(t, reply.Replies[0].Deleted)
+	assert.Equal(t, "Grace", got.Participant(reply.UserID).DisplayName)
+	assert.Nil(t, got.Participant(10))
+}
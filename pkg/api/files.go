@@ -0,0 +1,33 @@
+package api
+
+import "io"
+
+// File is a file stored in canvas
+type File struct {
+	ID            int64  `json:"id"`
+	UUID          string `json:"uuid"`
+	FolderID      int64  `json:"folder_id"`
+	DisplayName   string `json:"display_name"`
+	Filename      string `json:"filename"`
+	ContentType   string `json:"content-type"`
+	URL           string `json:"url"`
+	Size          int64  `json:"size"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	ModifiedAt    string `json:"modified_at"`
+	UnlockAt      string `json:"unlock_at"`
+	LockAt        string `json:"lock_at"`
+	Locked        bool   `json:"locked"`
+	Hidden        bool   `json:"hidden"`
+	HiddenForUser bool   `json:"hidden_for_user"`
+	LockedForUser bool   `json:"locked_for_user"`
+	ThumbnailURL  string `json:"thumbnail_url"`
+	MimeClass     string `json:"mime_class"`
+	MediaEntryID  string `json:"media_entry_id"`
+}
+
+// FileUpload is a file that is sent along with a request
+type FileUpload struct {
+	Name    string
+	Content io.Reader
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// GradeChangeEvent is an entry of the grade change audit log
+type GradeChangeEvent struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+	// EventType is "grade_change"
+	EventType            string  `json:"event_type"`
+	GradeBefore          string  `json:"grade_before"`
+	GradeAfter           string  `json:"grade_after"`
+	ExcusedBefore        bool    `json:"excused_before"`
+	ExcusedAfter         bool    `json:"excused_after"`
+	PointsPossibleBefore float64 `json:"points_possible_before"`
+	PointsPossibleAfter  float64 `json:"points_possible_after"`
+	GradedAnonymously    bool    `json:"graded_anonymously"`
+	VersionNumber        int64   `json:"version_number"`
+	RequestID            string  `json:"request_id"`
+	Links                struct {
+		AssignmentID int64 `json:"assignment"`
+		CourseID     int64 `json:"course"`
+		StudentID    int64 `json:"student"`
+		// GraderID is 0 when the grade was changed automatically, such as by a quiz
+		GraderID   int64  `json:"grader"`
+		PageViewID string `json:"page_view"`
+	} `json:"links"`
+}
+
+// GradeChangeLog is a list of grade change events along with the objects they refer to
+type GradeChangeLog struct {
+	Events      []GradeChangeEvent
+	Assignments []Assignment
+	Courses     []Course
+	Users       []User
+}
+
+// Assignment returns the assignment of the log with the given id, or nil if it is not linked
+func (l *GradeChangeLog) Assignment(id int64) *Assignment {
+	for i := range l.Assignments {
+		if l.Assignments[i].ID == id {
+			return &l.Assignments[i]
+		}
+	}
+
+	return nil
+}
+
+// Course returns the course of the log with the given id, or nil if it is not linked
+func (l *GradeChangeLog) Course(id int64) *Course {
+	for i := range l.Courses {
+		if l.Courses[i].ID == id {
+			return &l.Courses[i]
+		}
+	}
+
+	return nil
+}
+
+// User returns the student or grader of the log with the given id, or nil if it is not linked
+func (l *GradeChangeLog) User(id int64) *User {
+	for i := range l.Users {
+		if l.Users[i].ID == id {
+			return &l.Users[i]
+		}
+	}
+
+	return nil
+}
+
+// gradeChangeLogResponse is the envelope canvas wraps grade change events and their linked objects in
+type gradeChangeLogResponse struct {
+	Events []GradeChangeEvent `json:"events"`
+	Linked struct {
+		Assignments []Assignment `json:"assignments"`
+		Courses     []Course     `json:"courses"`
+		Users       []User       `json:"users"`
+	} `json:"linked"`
+}
+
+// GradeChangeOptions is an interface for the lookup of grade change events
+type GradeChangeOptions struct {
+	startTime time.Time
+	endTime   time.Time
+}
+
+// GradeChangeOption is an adapter for generating options
+type GradeChangeOption func(*GradeChangeOptions)
+
+// WithGradeChangeTimes limits the events to those that happened between the start and end times
+func WithGradeChangeTimes(startTime time.Time, endTime time.Time) GradeChangeOption {
+	return func(o *GradeChangeOptions) {
+		o.startTime = startTime
+		o.endTime = endTime
+	}
+}
+
+// GetAssignmentGradeChanges returns the grade changes of the assignment
+func (c *CanvasClient) GetAssignmentGradeChanges(assignmentID int64, setters ...GradeChangeOption) (*GradeChangeLog, error) {
+	return c.getGradeChanges(fmt.Sprintf("assignments/%d", assignmentID), setters)
+}
+
+// GetCourseGradeChanges returns the grade changes of the course
+func (c *CanvasClient) GetCourseGradeChanges(courseID int64, setters ...GradeChangeOption) (*GradeChangeLog, error) {
+	return c.getGradeChanges(fmt.Sprintf("courses/%d", courseID), setters)
+}
+
+// GetStudentGradeChanges returns the changes of the grades of the student
+func (c *CanvasClient) GetStudentGradeChanges(studentID int64, setters ...GradeChangeOption) (*GradeChangeLog, error) {
+	return c.getGradeChanges(fmt.Sprintf("students/%d", studentID), setters)
+}
+
+// GetGraderGradeChanges returns the grade changes made by the grader
+func (c *CanvasClient) GetGraderGradeChanges(graderID int64, setters ...GradeChangeOption) (*GradeChangeLog, error) {
+	return c.getGradeChanges(fmt.Sprintf("graders/%d", graderID), setters)
+}
+
+func (c *CanvasClient) getGradeChanges(path string, setters []GradeChangeOption) (*GradeChangeLog, error) {
+	args := &GradeChangeOptions{}
+	pages := make([]gradeChangeLogResponse, 0)
+	l := GradeChangeLog{Events: make([]GradeChangeEvent, 0)}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/audit/grade_change/%s", c.ClientURL(), path))
+
+	if err != nil {
+		return &l, err
+	}
+
+	q := parsedURL.Query()
+
+	if !args.startTime.IsZero() {
+		q.Add("start_time", args.startTime.Format(time.RFC3339))
+	}
+	if !args.endTime.IsZero() {
+		q.Add("end_time", args.endTime.Format(time.RFC3339))
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedObjects(parsedURL.String(), &pages)
+
+	if err != nil {
+		return &l, err
+	}
+
+	for _, page := range pages {
+		l.Events = append(l.Events, page.Events...)
+		l.Assignments = append(l.Assignments, page.Linked.Assignments...)
+		l.Courses = append(l.Courses, page.Linked.Courses...)
+		l.Users = append(l.Users, page.Linked.Users...)
+	}
+
+	return &l, nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetStudentGradeChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/audit/grade_change/students/8").
+		MatchParam("page", "2").
+		Reply(200).
+		BodyString(`{"events": [{"id": "e2", "grade_before": "B", "grade_after": "A",
+			"links": {"assignment": 41, "course": 5, "student": 8, "grader": null, "page_view": null}}],
+			"linked": {"assignments": [{"id": 41, "name": "Quiz"}], "courses": [], "users": []}}`)
+
+	gock.New(domain).
+		Get("/api/v1/audit/grade_change/students/8").
+		MatchParam("start_time", "2026-09-01T00:00:00Z").
+		Reply(200).
+		SetHeader("Link", `<https://domain.instructure.com/api/v1/audit/grade_change/students/8?page=2>; rel="next"`).
+		BodyString(`{"events": [{"id": "e1", "event_type": "grade_change", "grade_before": "C", "grade_after": "B",
+			"links": {"assignment": 40, "course": 5, "student": 8, "grader": 9, "page_view": "pv1"}}],
+			"linked": {"assignments": [{"id": 40, "name": "Essay"}], "courses": [{"id": 5, "name": "History"}],
+			"users": [{"id": 8, "name": "Ada"}, {"id": 9, "name": "Grace"}]}}`)
+
+	got, err := client.GetStudentGradeChanges(8, WithGradeChangeTimes(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), time.Time{}))
+	assert.Nil(t, err)
+	assert.Len(t, got.Events, 2)
+	assert.Equal(t, "Grace", got.User(got.Events[0].Links.GraderID).Name)
+	assert.Equal(t, "Quiz", got.Assignment(got.Events[1].Links.AssignmentID).Name)
+	assert.Equal(t, "History", got.Course(5).Name)
+	assert.Nil(t, got.User(got.Events[1].Links.GraderID))
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// CustomColumn is a custom column of the gradebook of a course
+type CustomColumn struct {
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	Position int64  `json:"position"`
+	Hidden   bool   `json:"hidden"`
+	// TeacherNotes marks the column that holds the notes of the teacher, a course has at most one
+	TeacherNotes bool `json:"teacher_notes"`
+	// ReadOnly prevents the column from being edited in the gradebook, the API can still update it
+	ReadOnly bool `json:"read_only"`
+}
+
+// ColumnDatum is the content of a custom column for a single user
+type ColumnDatum struct {
+	// ColumnID is only sent by the client for bulk updates
+	ColumnID int64  `json:"column_id,omitempty"`
+	UserID   int64  `json:"user_id"`
+	Content  string `json:"content"`
+}
+
+// GetCustomColumns returns the custom gradebook columns of the course.
+// When includeHidden is set, hidden columns are returned as well
+func (c *CanvasClient) GetCustomColumns(courseID int64, includeHidden bool) ([]CustomColumn, error) {
+	cc := make([]CustomColumn, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns", c.ClientURL(), courseID)
+	if includeHidden {
+		requestURL += "?include_hidden=true"
+	}
+
+	err := c.getPaginatedJSON(requestURL, &cc)
+
+	if err != nil {
+		return cc, err
+	}
+
+	return cc, nil
+}
+
+// CreateCustomColumn creates a custom gradebook column in the course
+func (c *CanvasClient) CreateCustomColumn(courseID int64, column *CustomColumn) (*CustomColumn, error) {
+	form, err := customColumnForm(column, nil)
+
+	if err != nil {
+		return &CustomColumn{}, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns", c.ClientURL(), courseID)
+
+	return c.sendCustomColumn("POST", requestURL, form)
+}
+
+// UpdateCustomColumn updates the named fields of the custom gradebook column, such as "title" or "hidden", to match the given one
+func (c *CanvasClient) UpdateCustomColumn(courseID int64, column *CustomColumn, fields ...string) (*CustomColumn, error) {
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &CustomColumn{}, err
+	}
+
+	form, err := customColumnForm(column, f)
+
+	if err != nil {
+		return &CustomColumn{}, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns/%d", c.ClientURL(), courseID, column.ID)
+
+	return c.sendCustomColumn("PUT", requestURL, form)
+}
+
+// DeleteCustomColumn deletes the custom gradebook column with the given columnID and returns it
+func (c *CanvasClient) DeleteCustomColumn(courseID int64, columnID int64) (*CustomColumn, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns/%d", c.ClientURL(), courseID, columnID)
+
+	return c.sendCustomColumn("DELETE", requestURL, nil)
+}
+
+func (c *CanvasClient) sendCustomColumn(method string, requestURL string, form url.Values) (*CustomColumn, error) {
+	cc := CustomColumn{}
+
+	err := c.sendJSON(method, requestURL, form, &cc)
+
+	if err != nil {
+		return &cc, err
+	}
+
+	return &cc, nil
+}
+
+func customColumnForm(column *CustomColumn, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("column[title]", column.Title)
+	}
+	if fields.has("position", column.Position != 0) {
+		form.Add("column[position]", strconv.FormatInt(column.Position, 10))
+	}
+	if fields.has("hidden", true) {
+		form.Add("column[hidden]", strconv.FormatBool(column.Hidden))
+	}
+	if fields.has("teacher_notes", true) {
+		form.Add("column[teacher_notes]", strconv.FormatBool(column.TeacherNotes))
+	}
+	if fields.has("read_only", true) {
+		form.Add("column[read_only]", strconv.FormatBool(column.ReadOnly))
+	}
+
+	return form, fields.unknown()
+}
+
+// ReorderCustomColumns puts the custom gradebook columns of the course in the order of the given columnIDs
+func (c *CanvasClient) ReorderCustomColumns(courseID int64, columnIDs ...int64) error {
+	form := url.Values{}
+	for _, id := range columnIDs {
+		form.Add("order[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns/reorder", c.ClientURL(), courseID)
+
+	return c.sendJSON("POST", requestURL, form, nil)
+}
+
+// GetCustomColumnData returns the content of the custom gradebook column for every user that has any.
+// When includeHidden is set, the content of users whose enrollments are hidden is returned as well
+func (c *CanvasClient) GetCustomColumnData(courseID int64, columnID int64, includeHidden bool) ([]ColumnDatum, error) {
+	d := make([]ColumnDatum, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns/%d/data", c.ClientURL(), courseID, columnID)
+	if includeHidden {
+		requestURL += "?include_hidden=true"
+	}
+
+	err := c.getPaginatedJSON(requestURL, &d)
+
+	if err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+// UpdateCustomColumnDatum sets the content of the custom gradebook column for the user
+func (c *CanvasClient) UpdateCustomColumnDatum(courseID int64, columnID int64, userID int64, content string) (*ColumnDatum, error) {
+	d := ColumnDatum{}
+
+	form := url.Values{}
+	form.Add("column_data[content]", content)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_columns/%d/data/%d", c.ClientURL(), courseID, columnID, userID)
+	err := c.sendJSON("PUT", requestURL, form, &d)
+
+	if err != nil {
+		return &d, err
+	}
+
+	return &d, nil
+}
+
+// UpdateCustomColumnData sets the content of any number of custom gradebook columns and users in the background.
+// Every datum must have its ColumnID set
+func (c *CanvasClient) UpdateCustomColumnData(courseID int64, data []ColumnDatum) (*Progress, error) {
+	p := Progress{}
+
+	payload := struct {
+		ColumnData []ColumnDatum `json:"column_data"`
+	}{data}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/custom_gradebook_column_data", c.ClientURL(), courseID)
+	err := c.sendJSONBody("PUT", requestURL, payload, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateCustomColumn(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/custom_gradebook_columns").
+		AddMatcher(matchForm(url.Values{
+			"column[title]":         {"Attendance"},
+			"column[hidden]":        {"false"},
+			"column[teacher_notes]": {"false"},
+			"column[read_only]":     {"true"},
+		})).
+		Reply(200).
+		JSON(CustomColumn{ID: 6, Title: "Attendance", Position: 1, ReadOnly: true})
+
+	got, err := client.CreateCustomColumn(5, &CustomColumn{Title: "Attendance", ReadOnly: true})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), got.ID)
+}
+
+func TestCanvasClient_UpdateCustomColumn(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/custom_gradebook_columns/3").
+		AddMatcher(matchForm(url.Values{"column[hidden]": {"true"}})).
+		Reply(200).
+		JSON(CustomColumn{ID: 3, Title: "Notes", TeacherNotes: true, Hidden: true})
+
+	got, err := client.UpdateCustomColumn(5, &CustomColumn{ID: 3, Hidden: true}, "hidden")
+	assert.Nil(t, err)
+	assert.True(t, got.TeacherNotes)
+
+	_, err = client.UpdateCustomColumn(5, &CustomColumn{ID: 3}, "hide")
+	assert.EqualError(t, err, `unknown fields to update: "hide"`)
+}
+
+func TestCanvasClient_ReorderCustomColumns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/custom_gradebook_columns/reorder").
+		BodyString("order%5B%5D=7&order%5B%5D=6").
+		Reply(200)
+
+	err := client.ReorderCustomColumns(5, 7, 6)
+	assert.Nil(t, err)
+}
+
+func TestCanvasClient_GetCustomColumnData(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/custom_gradebook_columns/6/data").
+		MatchParam("include_hidden", "true").
+		Reply(200).
+		BodyString(`[{"user_id": 8, "content": "3 absences"}]`)
+
+	got, err := client.GetCustomColumnData(5, 6, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "3 absences", got[0].Content)
+}
+
+func TestCanvasClient_UpdateCustomColumnData(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/custom_gradebook_column_data").
+		MatchType("json").
+		JSON(map[string]interface{}{"column_data": []map[string]interface{}{
+			{"column_id": 6, "user_id": 8, "content": "3 absences"},
+			{"column_id": 7, "user_id": 8, "content": "active"},
+		}}).
+		Reply(200).
+		BodyString(`{"id": 12, "workflow_state": "queued"}`)
+
+	got, err := client.UpdateCustomColumnData(5, []ColumnDatum{
+		{ColumnID: 6, UserID: 8, Content: "3 absences"},
+		{ColumnID: 7, UserID: 8, Content: "active"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), got.ID)
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GradebookHistoryDay is a day on which grades of a course were changed
+type GradebookHistoryDay struct {
+	// Date is formatted as YYYY-MM-DD
+	Date    string                   `json:"date"`
+	Graders []GradebookHistoryGrader `json:"graders"`
+}
+
+// GradebookHistoryGrader is a grader who changed grades of a course on a day
+type GradebookHistoryGrader struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// Assignments holds the IDs of the assignments the grader changed grades of
+	Assignments []int64 `json:"assignments"`
+}
+
+// SubmissionHistory is the list of versions of a submission
+type SubmissionHistory struct {
+	SubmissionID int64               `json:"submission_id"`
+	Versions     []SubmissionVersion `json:"versions"`
+}
+
+// SubmissionVersion is a submission as it was after a change of its grade
+type SubmissionVersion struct {
+	ID                            int64   `json:"id"`
+	AssignmentID                  int64   `json:"assignment_id"`
+	AssignmentName                string  `json:"assignment_name"`
+	UserID                        int64   `json:"user_id"`
+	UserName                      string  `json:"user_name"`
+	Body                          string  `json:"body"`
+	URL                           string  `json:"url"`
+	SubmissionType                string  `json:"submission_type"`
+	WorkflowState                 string  `json:"workflow_state"`
+	Score                         float64 `json:"score"`
+	GradeMatchesCurrentSubmission bool    `json:"grade_matches_current_submission"`
+	GradedAt                      string  `json:"graded_at"`
+	Grader                        string  `json:"grader"`
+	GraderID                      int64   `json:"grader_id"`
+	PreviousGrade                 string  `json:"previous_grade"`
+	PreviousGradedAt              string  `json:"previous_graded_at"`
+	PreviousGrader                string  `json:"previous_grader"`
+	NewGrade                      string  `json:"new_grade"`
+	NewGradedAt                   string  `json:"new_graded_at"`
+	NewGrader                     string  `json:"new_grader"`
+	CurrentGrade                  string  `json:"current_grade"`
+	CurrentGradedAt               string  `json:"current_graded_at"`
+	CurrentGrader                 string  `json:"current_grader"`
+}
+
+// GradebookFeedOptions is an interface for the lookup of the gradebook history feed
+type GradebookFeedOptions struct {
+	assignmentID int64
+	userID       int64
+	ascending    bool
+}
+
+// GradebookFeedOption is an adapter for generating options
+type GradebookFeedOption func(*GradebookFeedOptions)
+
+// WithFeedAssignment limits the feed to the versions of submissions to the assignment
+func WithFeedAssignment(assignmentID int64) GradebookFeedOption {
+	return func(o *GradebookFeedOptions) {
+		o.assignmentID = assignmentID
+	}
+}
+
+// WithFeedUser limits the feed to the versions of submissions of the user
+func WithFeedUser(userID int64) GradebookFeedOption {
+	return func(o *GradebookFeedOptions) {
+		o.userID = userID
+	}
+}
+
+// WithAscendingFeed returns the oldest versions first instead of the newest
+func WithAscendingFeed() GradebookFeedOption {
+	return func(o *GradebookFeedOptions) {
+		o.ascending = true
+	}
+}
+
+// GetGradebookHistoryDays returns the days on which grades of the course were changed
+func (c *CanvasClient) GetGradebookHistoryDays(courseID int64) ([]GradebookHistoryDay, error) {
+	d := make([]GradebookHistoryDay, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/gradebook_history/days", c.ClientURL(), courseID)
+	err := c.getPaginatedJSON(requestURL, &d)
+
+	if err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+// GetGradebookHistoryDay returns the graders who changed grades of the course on the date, formatted as YYYY-MM-DD
+func (c *CanvasClient) GetGradebookHistoryDay(courseID int64, date string) ([]GradebookHistoryGrader, error) {
+	g := make([]GradebookHistoryGrader, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/gradebook_history/%s", c.ClientURL(), courseID, url.PathEscape(date))
+	err := c.getPaginatedJSON(requestURL, &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetGradebookHistorySubmissions returns the versions of the submissions to the assignment that the grader
+// changed on the date, formatted as YYYY-MM-DD
+func (c *CanvasClient) GetGradebookHistorySubmissions(courseID int64, date string, graderID int64, assignmentID int64) ([]SubmissionHistory, error) {
+	s := make([]SubmissionHistory, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/gradebook_history/%s/graders/%d/assignments/%d/submissions",
+		c.ClientURL(), courseID, url.PathEscape(date), graderID, assignmentID)
+	err := c.getPaginatedJSON(requestURL, &s)
+
+	if err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// GetGradebookHistoryFeed returns the versions of the submissions of the course, newest first
+func (c *CanvasClient) GetGradebookHistoryFeed(courseID int64, setters ...GradebookFeedOption) ([]SubmissionVersion, error) {
+	args := &GradebookFeedOptions{}
+	v := make([]SubmissionVersion, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/courses/%d/gradebook_history/feed", c.ClientURL(), courseID))
+
+	if err != nil {
+		return v, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.assignmentID != 0 {
+		q.Add("assignment_id", strconv.FormatInt(args.assignmentID, 10))
+	}
+	if args.userID != 0 {
+		q.Add("user_id", strconv.FormatInt(args.userID, 10))
+	}
+	if args.ascending {
+		q.Add("ascending", "true")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &v)
+
+	if err != nil {
+		return v, err
+	}
+
+	return v, nil
+}
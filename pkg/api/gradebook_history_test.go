@@ -0,0 +1,49 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetGradebookHistoryDays(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/gradebook_history/days").
+		Reply(200).
+		BodyString(`[{"date": "2026-10-01", "graders": [{"id": 9, "name": "Grace", "assignments": [40, 41]}]}]`)
+
+	got, err := client.GetGradebookHistoryDays(5)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{40, 41}, got[0].Graders[0].Assignments)
+}
+
+func TestCanvasClient_GetGradebookHistorySubmissions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/gradebook_history/2026-10-01/graders/9/assignments/40/submissions").
+		Reply(200).
+		BodyString(`[{"submission_id": 70, "versions": [{"id": 70, "previous_grade": "B", "new_grade": "A", "grader_id": 9}]}]`)
+
+	got, err := client.GetGradebookHistorySubmissions(5, "2026-10-01", 9, 40)
+	assert.Nil(t, err)
+	assert.Equal(t, "A", got[0].Versions[0].NewGrade)
+}
+
+func TestCanvasClient_GetGradebookHistoryFeed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/gradebook_history/feed").
+		MatchParam("assignment_id", "40").
+		MatchParam("ascending", "true").
+		Reply(200).
+		JSON([]SubmissionVersion{{ID: 70, AssignmentID: 40, UserID: 8, CurrentGrade: "A"}})
+
+	got, err := client.GetGradebookHistoryFeed(5, WithFeedAssignment(40), WithAscendingFeed())
+	assert.Nil(t, err)
+	assert.Equal(t, "A", got[0].CurrentGrade)
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GradingPeriod is a span of a term that is graded separately
+type GradingPeriod struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	// CloseDate is the date after which grades of the period cannot be changed anymore
+	CloseDate string `json:"close_date"`
+	// Weight is the percentage the period counts towards the final grade when its set is weighted
+	Weight   float64 `json:"weight"`
+	IsClosed bool    `json:"is_closed"`
+}
+
+// GradingPeriodSet is a set of grading periods of an account that applies to the courses of its enrollment terms
+type GradingPeriodSet struct {
+	ID                                int64           `json:"id"`
+	Title                             string          `json:"title"`
+	Weighted                          bool            `json:"weighted"`
+	DisplayTotalsForAllGradingPeriods bool            `json:"display_totals_for_all_grading_periods"`
+	EnrollmentTermIDs                 []int64         `json:"enrollment_term_ids"`
+	GradingPeriods                    []GradingPeriod `json:"grading_periods"`
+	CreatedAt                         string          `json:"created_at"`
+	UpdatedAt                         string          `json:"updated_at"`
+}
+
+// gradingPeriodsResponse is the envelope canvas wraps grading periods in
+type gradingPeriodsResponse struct {
+	GradingPeriods []GradingPeriod `json:"grading_periods"`
+}
+
+// gradingPeriodSetsResponse is the envelope canvas wraps grading period sets in
+type gradingPeriodSetsResponse struct {
+	GradingPeriodSets []GradingPeriodSet `json:"grading_period_sets"`
+}
+
+// gradingPeriodSetResponse is the envelope canvas wraps a single grading period set in
+type gradingPeriodSetResponse struct {
+	GradingPeriodSet GradingPeriodSet `json:"grading_period_set"`
+}
+
+// GetGradingPeriods returns the grading periods of the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetGradingPeriods(contextCode string) ([]GradingPeriod, error) {
+	pages := make([]gradingPeriodsResponse, 0)
+	g := make([]GradingPeriod, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return g, err
+	}
+
+	err = c.getPaginatedObjects(contextURL+"/grading_periods", &pages)
+
+	if err != nil {
+		return g, err
+	}
+
+	for _, page := range pages {
+		g = append(g, page.GradingPeriods...)
+	}
+
+	return g, nil
+}
+
+// GetGradingPeriod returns the grading period of the course with the given periodID
+func (c *CanvasClient) GetGradingPeriod(courseID int64, periodID int64) (*GradingPeriod, error) {
+	r := gradingPeriodsResponse{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/grading_periods/%d", c.ClientURL(), courseID, periodID)
+	err := c.getJSON(requestURL, &r)
+
+	if err != nil {
+		return &GradingPeriod{}, err
+	}
+
+	if len(r.GradingPeriods) == 0 {
+		return &GradingPeriod{}, fmt.Errorf("grading period %d not found", periodID)
+	}
+
+	return &r.GradingPeriods[0], nil
+}
+
+// UpdateGradingPeriod updates the named fields of the grading period of the course, such as "title" or "close_date", to match the given one
+func (c *CanvasClient) UpdateGradingPeriod(courseID int64, period *GradingPeriod, fields ...string) (*GradingPeriod, error) {
+	r := gradingPeriodsResponse{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &GradingPeriod{}, err
+	}
+
+	form, err := gradingPeriodsForm([]GradingPeriod{*period}, f)
+
+	if err != nil {
+		return &GradingPeriod{}, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/grading_periods/%d", c.ClientURL(), courseID, period.ID)
+	err = c.sendJSON("PUT", requestURL, form, &r)
+
+	if err != nil {
+		return &GradingPeriod{}, err
+	}
+
+	if len(r.GradingPeriods) == 0 {
+		return &GradingPeriod{}, fmt.Errorf("grading period %d not found", period.ID)
+	}
+
+	return &r.GradingPeriods[0], nil
+}
+
+// DeleteGradingPeriod deletes the grading period of the context with the given periodID
+func (c *CanvasClient) DeleteGradingPeriod(contextCode string, periodID int64) error {
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return err
+	}
+
+	return c.sendJSON("DELETE", fmt.Sprintf("%s/grading_periods/%d", contextURL, periodID), nil, nil)
+}
+
+// BatchUpdateGradingPeriods creates the periods without an ID and updates the periods with one in the grading period set.
+// The close date and weight of a period are only sent when they are set. It returns every period of the set
+func (c *CanvasClient) BatchUpdateGradingPeriods(setID int64, periods []GradingPeriod) ([]GradingPeriod, error) {
+	r := gradingPeriodsResponse{}
+
+	form, err := gradingPeriodsForm(periods, nil)
+
+	if err != nil {
+		return make([]GradingPeriod, 0), err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/grading_period_sets/%d/grading_periods/batch_update", c.ClientURL(), setID)
+	err = c.sendJSON("PATCH", requestURL, form, &r)
+
+	if err != nil {
+		return make([]GradingPeriod, 0), err
+	}
+
+	return r.GradingPeriods, nil
+}
+
+func gradingPeriodsForm(periods []GradingPeriod, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	for i, period := range periods {
+		key := fmt.Sprintf("grading_periods[%d]", i)
+		if period.ID != 0 {
+			form.Add(key+"[id]", strconv.FormatInt(period.ID, 10))
+		}
+		if fields.has("title", true) {
+			form.Add(key+"[title]", period.Title)
+		}
+		if fields.has("start_date", true) {
+			form.Add(key+"[start_date]", period.StartDate)
+		}
+		if fields.has("end_date", true) {
+			form.Add(key+"[end_date]", period.EndDate)
+		}
+		if fields.has("close_date", period.CloseDate != "") {
+			form.Add(key+"[close_date]", period.CloseDate)
+		}
+		if fields.has("weight", period.Weight != 0) {
+			form.Add(key+"[weight]", strconv.FormatFloat(period.Weight, 'f', -1, 64))
+		}
+	}
+
+	return form, fields.unknown()
+}
+
+// GetGradingPeriodSets returns the grading period sets of the account
+func (c *CanvasClient) GetGradingPeriodSets(accountID int64) ([]GradingPeriodSet, error) {
+	pages := make([]gradingPeriodSetsResponse, 0)
+	s := make([]GradingPeriodSet, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/accounts/%d/grading_period_sets", c.ClientURL(), accountID)
+	err := c.getPaginatedObjects(requestURL, &pages)
+
+	if err != nil {
+		return s, err
+	}
+
+	for _, page := range pages {
+		s = append(s, page.GradingPeriodSets...)
+	}
+
+	return s, nil
+}
+
+// CreateGradingPeriodSet creates a grading period set in the account for the terms of its EnrollmentTermIDs.
+// The periods of the set are created with BatchUpdateGradingPeriods
+func (c *CanvasClient) CreateGradingPeriodSet(accountID int64, set *GradingPeriodSet) (*GradingPeriodSet, error) {
+	r := gradingPeriodSetResponse{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/accounts/%d/grading_period_sets", c.ClientURL(), accountID)
+	err := c.sendJSON("POST", requestURL, gradingPeriodSetForm(set), &r)
+
+	if err != nil {
+		return &r.GradingPeriodSet, err
+	}
+
+	return &r.GradingPeriodSet, nil
+}
+
+// UpdateGradingPeriodSet updates the grading period set of the account to match the given one
+func (c *CanvasClient) UpdateGradingPeriodSet(accountID int64, set *GradingPeriodSet) error {
+	requestURL := fmt.Sprintf("%s/api/v1/accounts/%d/grading_period_sets/%d", c.ClientURL(), accountID, set.ID)
+
+	return c.sendJSON("PATCH", requestURL, gradingPeriodSetForm(set), nil)
+}
+
+// DeleteGradingPeriodSet deletes the grading period set of the account with the given setID and its periods
+func (c *CanvasClient) DeleteGradingPeriodSet(accountID int64, setID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/accounts/%d/grading_period_sets/%d", c.ClientURL(), accountID, setID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func gradingPeriodSetForm(set *GradingPeriodSet) url.Values {
+	form := url.Values{}
+	form.Add("grading_period_set[title]", set.Title)
+	form.Add("grading_period_set[weighted]", strconv.FormatBool(set.Weighted))
+	form.Add("grading_period_set[display_totals_for_all_grading_periods]", strconv.FormatBool(set.DisplayTotalsForAllGradingPeriods))
+	for _, id := range set.EnrollmentTermIDs {
+		form.Add("enrollment_term_ids[]", strconv.FormatInt(id, 10))
+	}
+
+	return form
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetGradingPeriods(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/grading_periods").
+		Reply(200).
+		BodyString(`{"grading_periods": [{"id": 1, "title": "Q1", "start_date": "2026-09-01T00:00:00Z",
+			"end_date": "2026-11-01T00:00:00Z", "weight": 25, "is_closed": true}], "meta": {"primaryCollection": "grading_periods"}}`)
+
+	got, err := client.GetGradingPeriods("course_5")
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, 25.0, got[0].Weight)
+	assert.True(t, got[0].IsClosed)
+}
+
+func TestCanvasClient_BatchUpdateGradingPeriods(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Patch("/api/v1/grading_period_sets/3/grading_periods/batch_update").
+		AddMatcher(matchForm(url.Values{
+			"grading_periods[0][id]":         {"1"},
+			"grading_periods[0][title]":      {"Q1"},
+			"grading_periods[0][start_date]": {"2021-01-01T00:00:00Z"},
+			"grading_periods[0][end_date]":   {"2021-03-31T00:00:00Z"},
+			"grading_periods[0][close_date]": {"2021-04-07T00:00:00Z"},
+			"grading_periods[0][weight]":     {"40"},
+			"grading_periods[1][title]":      {"Q2"},
+			"grading_periods[1][start_date]": {"2021-04-01T00:00:00Z"},
+			"grading_periods[1][end_date]":   {"2021-06-30T00:00:00Z"},
+			"grading_periods[1][close_date]": {"2021-07-07T00:00:00Z"},
+			"grading_periods[1][weight]":     {"60"},
+		})).
+		Reply(200).
+		BodyString(`{"grading_periods": [{"id": 1, "title": "Q1"}, {"id": 2, "title": "Q2"}]}`)
+
+	got, err := client.BatchUpdateGradingPeriods(3, []GradingPeriod{
+		{ID: 1, Title: "Q1", StartDate: "2021-01-01T00:00:00Z", EndDate: "2021-03-31T00:00:00Z", CloseDate: "2021-04-07T00:00:00Z", Weight: 40},
+		{Title: "Q2", StartDate: "2021-04-01T00:00:00Z", EndDate: "2021-06-30T00:00:00Z", CloseDate: "2021-07-07T00:00:00Z", Weight: 60},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), got[1].ID)
+}
+
+func TestCanvasClient_UpdateGradingPeriod(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/grading_periods/1").
+		AddMatcher(matchForm(url.Values{
+			"grading_periods[0][id]":         {"1"},
+			"grading_periods[0][close_date]": {"2021-04-14T00:00:00Z"},
+		})).
+		Reply(200).
+		BodyString(`{"grading_periods": [{"id": 1, "title": "Q1", "close_date": "2021-04-14T00:00:00Z", "weight": 40}]}`)
+
+	got, err := client.UpdateGradingPeriod(5, &GradingPeriod{ID: 1, CloseDate: "2021-04-14T00:00:00Z"}, "close_date")
+	assert.Nil(t, err)
+	assert.Equal(t, 40.0, got.Weight)
+
+	_, err = client.UpdateGradingPeriod(5, &GradingPeriod{ID: 1}, "closes_at")
+	assert.EqualError(t, err, `unknown fields to update: "closes_at"`)
+}
+
+func TestCanvasClient_CreateGradingPeriodSet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/accounts/1/grading_period_sets").
+		AddMatcher(matchForm(url.Values{
+			"grading_period_set[title]":                                  {"Fall"},
+			"grading_period_set[weighted]":                               {"true"},
+			"grading_period_set[display_totals_for_all_grading_periods]": {"false"},
+			"enrollment_term_ids[]":                                      {"4"},
+		})).
+		Reply(200).
+		BodyString(`{"grading_period_set": {"id": 3, "title": "Fall", "weighted": true, "enrollment_term_ids": [4], "grading_periods": []}}`)
+
+	got, err := client.CreateGradingPeriodSet(1, &GradingPeriodSet{Title: "Fall", Weighted: true, EnrollmentTermIDs: []int64{4}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), got.ID)
+	assert.Equal(t, []int64{4}, got.EnrollmentTermIDs)
+}
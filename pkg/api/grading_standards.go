@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+)
+
+// GradingStandard is a scheme that maps scores to letter grades
+type GradingStandard struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	ContextType string `json:"context_type"`
+	ContextID   int64  `json:"context_id"`
+	// GradingScheme is ordered from the highest to the lowest grade
+	GradingScheme []GradingSchemeEntry `json:"grading_scheme"`
+	PointsBased   bool                 `json:"points_based"`
+	ScalingFactor float64              `json:"scaling_factor"`
+}
+
+// GradingSchemeEntry is a grade of a grading standard
+type GradingSchemeEntry struct {
+	Name string `json:"name"`
+	// Value is the lowest score of the grade as a fraction, such as 0.93 for 93%
+	Value float64 `json:"value"`
+}
+
+// GetGradingStandards returns the grading standards available in the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetGradingStandards(contextCode string) ([]GradingStandard, error) {
+	g := make([]GradingStandard, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return g, err
+	}
+
+	err = c.getPaginatedJSON(contextURL+"/grading_standards", &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetGradingStandard returns the grading standard of the context with the given standardID
+func (c *CanvasClient) GetGradingStandard(contextCode string, standardID int64) (*GradingStandard, error) {
+	g := GradingStandard{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &g, err
+	}
+
+	err = c.getJSON(fmt.Sprintf("%s/grading_standards/%d", contextURL, standardID), &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+// CreateGradingStandard creates a grading standard in the context
+func (c *CanvasClient) CreateGradingStandard(contextCode string, standard *GradingStandard) (*GradingStandard, error) {
+	return c.sendGradingStandard("POST", contextCode, "/grading_standards", gradingStandardForm(standard))
+}
+
+// UpdateGradingStandard updates the grading standard of the context to match the given one
+func (c *CanvasClient) UpdateGradingStandard(contextCode string, standard *GradingStandard) (*GradingStandard, error) {
+	return c.sendGradingStandard("PUT", contextCode, fmt.Sprintf("/grading_standards/%d", standard.ID), gradingStandardForm(standard))
+}
+
+// DeleteGradingStandard deletes the grading standard of the context with the given standardID and returns it
+func (c *CanvasClient) DeleteGradingStandard(contextCode string, standardID int64) (*GradingStandard, error) {
+	return c.sendGradingStandard("DELETE", contextCode, fmt.Sprintf("/grading_standards/%d", standardID), nil)
+}
+
+func (c *CanvasClient) sendGradingStandard(method string, contextCode string, path string, form url.Values) (*GradingStandard, error) {
+	g := GradingStandard{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &g, err
+	}
+
+	err = c.sendJSON(method, contextURL+path, form, &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+// gradingStandardForm encodes the standard, canvas expects the values of its entries as percentages
+func gradingStandardForm(standard *GradingStandard) url.Values {
+	form := url.Values{}
+	form.Add("title", standard.Title)
+	if standard.PointsBased {
+		form.Add("points_based", "true")
+		form.Add("scaling_factor", strconv.FormatFloat(standard.ScalingFactor, 'f', -1, 64))
+	}
+	for i, entry := range standard.GradingScheme {
+		key := fmt.Sprintf("grading_scheme_entry[%d]", i)
+		form.Add(key+"[name]", entry.Name)
+		// rounding drops the float error of the scaling, which turns 0.57 into 56.99999999999999
+		form.Add(key+"[value]", strconv.FormatFloat(math.Round(entry.Value*1e6)/1e4, 'f', -1, 64))
+	}
+
+	return form
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateGradingStandard(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/accounts/1/grading_standards").
+		AddMatcher(matchForm(url.Values{
+			"title":                          {"Letters"},
+			"grading_scheme_entry[0][name]":  {"A"},
+			"grading_scheme_entry[0][value]": {"93"},
+			"grading_scheme_entry[1][name]":  {"C"},
+			"grading_scheme_entry[1][value]": {"57"},
+			"grading_scheme_entry[2][name]":  {"F"},
+			"grading_scheme_entry[2][value]": {"0"},
+		})).
+		Reply(200).
+		BodyString(`{"id": 2, "title": "Letters", "context_type": "Account", "context_id": 1,
+			"grading_scheme": [{"name": "A", "value": 0.93}, {"name": "C", "value": 0.57}, {"name": "F", "value": 0}]}`)
+
+	got, err := client.CreateGradingStandard("account_1", &GradingStandard{
+		Title:         "Letters",
+		GradingScheme: []GradingSchemeEntry{{Name: "A", Value: 0.93}, {Name: "C", Value: 0.57}, {Name: "F", Value: 0}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0.93, got.GradingScheme[0].Value)
+}
+
+func TestCanvasClient_GetGradingStandards(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/grading_standards").
+		Reply(200).
+		JSON([]GradingStandard{{ID: 2, Title: "Letters", ContextType: "Account"}})
+
+	got, err := client.GetGradingStandards("course_5")
+	assert.Nil(t, err)
+	assert.Equal(t, "Letters", got[0].Title)
+}
@@ -0,0 +1,497 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GroupCategory is a set of groups of a course or an account
+type GroupCategory struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+	// SelfSignup is "enabled", "restricted" to students of the same section, or empty when students cannot sign up
+	SelfSignup string `json:"self_signup"`
+	// AutoLeader is "first" or "random", or empty when groups get no leader
+	AutoLeader                string    `json:"auto_leader"`
+	ContextType               string    `json:"context_type"`
+	AccountID                 int64     `json:"account_id"`
+	CourseID                  int64     `json:"course_id"`
+	GroupLimit                int64     `json:"group_limit"`
+	SisGroupCategoryID        string    `json:"sis_group_category_id"`
+	SisImportID               int64     `json:"sis_import_id"`
+	Progress                  *Progress `json:"progress"`
+	GroupsCount               int64     `json:"groups_count"`
+	UnassignedUsersCount      int64     `json:"unassigned_users_count"`
+	Protected                 bool      `json:"protected"`
+	AllowsMultipleMemberships bool      `json:"allows_multiple_memberships"`
+	IsMember                  bool      `json:"is_member"`
+	// CreateGroupCount creates that many groups along with a new category, it is only sent by the client
+	CreateGroupCount int64 `json:"-"`
+}
+
+// Group is a group of users
+type Group struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	IsPublic       bool   `json:"is_public"`
+	FollowedByUser bool   `json:"followed_by_user"`
+	// JoinLevel is one of "parent_context_auto_join", "parent_context_request" or "invitation_only"
+	JoinLevel       string          `json:"join_level"`
+	MembersCount    int64           `json:"members_count"`
+	AvatarURL       string          `json:"avatar_url"`
+	ContextType     string          `json:"context_type"`
+	CourseID        int64           `json:"course_id"`
+	AccountID       int64           `json:"account_id"`
+	Role            string          `json:"role"`
+	GroupCategoryID int64           `json:"group_category_id"`
+	SisGroupID      string          `json:"sis_group_id"`
+	SisImportID     int64           `json:"sis_import_id"`
+	StorageQuotaMB  int64           `json:"storage_quota_mb"`
+	Permissions     map[string]bool `json:"permissions"`
+	// Users is only set when the users are included
+	Users []User `json:"users"`
+}
+
+// GroupMembership is the membership of a user in a group
+type GroupMembership struct {
+	ID      int64 `json:"id"`
+	GroupID int64 `json:"group_id"`
+	UserID  int64 `json:"user_id"`
+	// WorkflowState is one of "accepted", "invited" or "requested"
+	WorkflowState string `json:"workflow_state"`
+	Moderator     bool   `json:"moderator"`
+	JustCreated   bool   `json:"just_created"`
+	SisImportID   int64  `json:"sis_import_id"`
+}
+
+// GetGroupCategories returns the group categories of the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetGroupCategories(contextCode string) ([]GroupCategory, error) {
+	g := make([]GroupCategory, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return g, err
+	}
+
+	err = c.getPaginatedJSON(contextURL+"/group_categories", &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetGroupCategory returns the group category with the given categoryID
+func (c *CanvasClient) GetGroupCategory(categoryID int64) (*GroupCategory, error) {
+	g := GroupCategory{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d", c.ClientURL(), categoryID)
+	err := c.getJSON(requestURL, &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+// CreateGroupCategory creates a group category in the context
+func (c *CanvasClient) CreateGroupCategory(contextCode string, category *GroupCategory) (*GroupCategory, error) {
+	created := GroupCategory{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &created, err
+	}
+
+	form, err := groupCategoryForm(category, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	if category.CreateGroupCount != 0 {
+		form.Add("create_group_count", strconv.FormatInt(category.CreateGroupCount, 10))
+	}
+
+	err = c.sendJSON("POST", contextURL+"/group_categories", form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateGroupCategory updates the named fields of the group category, such as "name" or "self_signup", to match the given one
+func (c *CanvasClient) UpdateGroupCategory(category *GroupCategory, fields ...string) (*GroupCategory, error) {
+	updated := GroupCategory{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := groupCategoryForm(category, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d", c.ClientURL(), category.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteGroupCategory deletes the group category with the given categoryID and all of its groups
+func (c *CanvasClient) DeleteGroupCategory(categoryID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d", c.ClientURL(), categoryID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func groupCategoryForm(category *GroupCategory, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("name", true) {
+		form.Add("name", category.Name)
+	}
+	if fields.has("self_signup", true) {
+		form.Add("self_signup", category.SelfSignup)
+	}
+	if fields.has("auto_leader", true) {
+		form.Add("auto_leader", category.AutoLeader)
+	}
+	if fields.has("group_limit", category.GroupLimit != 0) {
+		form.Add("group_limit", strconv.FormatInt(category.GroupLimit, 10))
+	}
+	if fields.has("sis_group_category_id", category.SisGroupCategoryID != "") {
+		form.Add("sis_group_category_id", category.SisGroupCategoryID)
+	}
+
+	return form, fields.unknown()
+}
+
+// GetGroupCategoryGroups returns the groups of the group category
+func (c *CanvasClient) GetGroupCategoryGroups(categoryID int64) ([]Group, error) {
+	return c.getGroups(fmt.Sprintf("%s/api/v1/group_categories/%d/groups", c.ClientURL(), categoryID))
+}
+
+// GetGroupCategoryUsers returns the users of the context of the group category.
+// When unassigned is set, only users that are not in any group of the category are returned
+func (c *CanvasClient) GetGroupCategoryUsers(categoryID int64, unassigned bool) ([]User, error) {
+	u := make([]User, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d/users", c.ClientURL(), categoryID)
+	if unassigned {
+		requestURL += "?unassigned=true"
+	}
+
+	err := c.getPaginatedJSON(requestURL, &u)
+
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// AssignUnassignedMembers spreads the unassigned users of the group category evenly over its groups in the background
+func (c *CanvasClient) AssignUnassignedMembers(categoryID int64) (*Progress, error) {
+	p := Progress{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d/assign_unassigned_members", c.ClientURL(), categoryID)
+	err := c.sendJSON("POST", requestURL, nil, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
+
+// ExportGroupCategory returns the groups and memberships of the group category as CSV
+func (c *CanvasClient) ExportGroupCategory(categoryID int64) (string, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d/export", c.ClientURL(), categoryID)
+
+	return c.getText(requestURL)
+}
+
+// ImportGroupCategory creates the groups and memberships of the CSV in the group category in the background.
+// The CSV has the columns of ExportGroupCategory
+func (c *CanvasClient) ImportGroupCategory(categoryID int64, csv *FileUpload) (*Progress, error) {
+	p := Progress{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d/import", c.ClientURL(), categoryID)
+	err := c.sendMultipart("POST", requestURL, url.Values{}, "attachment", csv, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
+
+// GetGroups returns the groups of the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetGroups(contextCode string) ([]Group, error) {
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return make([]Group, 0), err
+	}
+
+	return c.getGroups(contextURL + "/groups")
+}
+
+// GetUserGroups returns the groups the user is a member of
+func (c *CanvasClient) GetUserGroups() ([]Group, error) {
+	return c.getGroups(fmt.Sprintf("%s/api/v1/users/self/groups", c.ClientURL()))
+}
+
+func (c *CanvasClient) getGroups(requestURL string) ([]Group, error) {
+	g := make([]Group, 0)
+
+	err := c.getPaginatedJSON(requestURL, &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetGroup returns the group with the given groupID
+func (c *CanvasClient) GetGroup(groupID int64) (*Group, error) {
+	g := Group{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d", c.ClientURL(), groupID)
+	err := c.getJSON(requestURL, &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+// CreateGroup creates a group in the group category
+func (c *CanvasClient) CreateGroup(categoryID int64, group *Group) (*Group, error) {
+	form, err := groupForm(group, nil)
+
+	if err != nil {
+		return &Group{}, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/group_categories/%d/groups", c.ClientURL(), categoryID)
+
+	return c.sendGroup("POST", requestURL, form)
+}
+
+// UpdateGroup updates the named fields of the group, such as "name" or "join_level", to match the given one
+func (c *CanvasClient) UpdateGroup(group *Group, fields ...string) (*Group, error) {
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &Group{}, err
+	}
+
+	form, err := groupForm(group, f)
+
+	if err != nil {
+		return &Group{}, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d", c.ClientURL(), group.ID)
+
+	return c.sendGroup("PUT", requestURL, form)
+}
+
+// SetGroupMembers replaces the members of the group with the users with the given userIDs
+func (c *CanvasClient) SetGroupMembers(groupID int64, userIDs ...int64) (*Group, error) {
+	form := url.Values{}
+	for _, id := range userIDs {
+		form.Add("members[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d", c.ClientURL(), groupID)
+
+	return c.sendGroup("PUT", requestURL, form)
+}
+
+// DeleteGroup deletes the group with the given groupID and returns it
+func (c *CanvasClient) DeleteGroup(groupID int64) (*Group, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d", c.ClientURL(), groupID)
+
+	return c.sendGroup("DELETE", requestURL, nil)
+}
+
+func (c *CanvasClient) sendGroup(method string, requestURL string, form url.Values) (*Group, error) {
+	g := Group{}
+
+	err := c.sendJSON(method, requestURL, form, &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+func groupForm(group *Group, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("name", true) {
+		form.Add("name", group.Name)
+	}
+	if fields.has("description", true) {
+		form.Add("description", group.Description)
+	}
+	if fields.has("is_public", true) {
+		form.Add("is_public", strconv.FormatBool(group.IsPublic))
+	}
+	if fields.has("join_level", group.JoinLevel != "") {
+		form.Add("join_level", group.JoinLevel)
+	}
+	if fields.has("storage_quota_mb", group.StorageQuotaMB != 0) {
+		form.Add("storage_quota_mb", strconv.FormatInt(group.StorageQuotaMB, 10))
+	}
+	if fields.has("sis_group_id", group.SisGroupID != "") {
+		form.Add("sis_group_id", group.SisGroupID)
+	}
+
+	return form, fields.unknown()
+}
+
+// GetGroupUsers returns the users of the group.
+// An empty searchTerm returns every user
+func (c *CanvasClient) GetGroupUsers(groupID int64, searchTerm string) ([]User, error) {
+	u := make([]User, 0)
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/groups/%d/users", c.ClientURL(), groupID))
+
+	if err != nil {
+		return u, err
+	}
+
+	if searchTerm != "" {
+		q := parsedURL.Query()
+		q.Add("search_term", searchTerm)
+		parsedURL.RawQuery = q.Encode()
+	}
+
+	err = c.getPaginatedJSON(parsedURL.String(), &u)
+
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// GetGroupMemberships returns the memberships of the group.
+// States can be any of: {"accepted" | "invited" | "requested"}, no states returns every membership
+func (c *CanvasClient) GetGroupMemberships(groupID int64, states ...string) ([]GroupMembership, error) {
+	m := make([]GroupMembership, 0)
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/groups/%d/memberships", c.ClientURL(), groupID))
+
+	if err != nil {
+		return m, err
+	}
+
+	q := parsedURL.Query()
+	for _, state := range states {
+		q.Add("filter_states[]", state)
+	}
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &m)
+
+	if err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// InviteToGroup invites the people with the given email addresses to the group
+func (c *CanvasClient) InviteToGroup(groupID int64, emails ...string) error {
+	form := url.Values{}
+	for _, email := range emails {
+		form.Add("invitees[]", email)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/invite", c.ClientURL(), groupID)
+
+	return c.sendJSON("POST", requestURL, form, nil)
+}
+
+// JoinGroup makes the user a member of the group, or requests membership when the group requires it
+func (c *CanvasClient) JoinGroup(groupID int64) (*GroupMembership, error) {
+	return c.addGroupMember(groupID, "self")
+}
+
+// AddGroupMember makes the user with the given userID a member of the group
+func (c *CanvasClient) AddGroupMember(groupID int64, userID int64) (*GroupMembership, error) {
+	return c.addGroupMember(groupID, strconv.FormatInt(userID, 10))
+}
+
+func (c *CanvasClient) addGroupMember(groupID int64, userID string) (*GroupMembership, error) {
+	form := url.Values{}
+	form.Add("user_id", userID)
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/memberships", c.ClientURL(), groupID)
+
+	return c.sendGroupMembership("POST", requestURL, form)
+}
+
+// UpdateGroupMembership accepts a requested membership or changes whether the member moderates the group
+func (c *CanvasClient) UpdateGroupMembership(membership *GroupMembership) (*GroupMembership, error) {
+	form := url.Values{}
+	form.Add("moderator", strconv.FormatBool(membership.Moderator))
+	if membership.WorkflowState == "accepted" {
+		form.Add("workflow_state", "accepted")
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/memberships/%d", c.ClientURL(), membership.GroupID, membership.ID)
+
+	return c.sendGroupMembership("PUT", requestURL, form)
+}
+
+func (c *CanvasClient) sendGroupMembership(method string, requestURL string, form url.Values) (*GroupMembership, error) {
+	m := GroupMembership{}
+
+	err := c.sendJSON(method, requestURL, form, &m)
+
+	if err != nil {
+		return &m, err
+	}
+
+	return &m, nil
+}
+
+// LeaveGroup ends the membership of the user in the group
+func (c *CanvasClient) LeaveGroup(groupID int64) error {
+	return c.removeGroupMember(groupID, "self")
+}
+
+// RemoveGroupMember ends the membership of the user with the given userID in the group
+func (c *CanvasClient) RemoveGroupMember(groupID int64, userID int64) error {
+	return c.removeGroupMember(groupID, strconv.FormatInt(userID, 10))
+}
+
+func (c *CanvasClient) removeGroupMember(groupID int64, userID string) error {
+	requestURL := fmt.Sprintf("%s/api/v1/groups/%d/users/%s", c.ClientURL(), groupID, userID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateGroupCategory(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/group_categories").
+		AddMatcher(matchForm(url.Values{
+			"name":               {"Projects"},
+			"self_signup":        {"restricted"},
+			"auto_leader":        {""},
+			"create_group_count": {"4"},
+		})).
+		Reply(200).
+		JSON(GroupCategory{ID: 11, Name: "Projects", SelfSignup: "restricted", GroupsCount: 4})
+
+	got, err := client.CreateGroupCategory("course_5", &GroupCategory{Name: "Projects", SelfSignup: "restricted", CreateGroupCount: 4})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), got.GroupsCount)
+}
+
+func TestCanvasClient_UpdateGroupCategory(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/group_categories/11").
+		AddMatcher(matchForm(url.Values{"group_limit": {"5"}})).
+		Reply(200).
+		JSON(GroupCategory{ID: 11, Name: "Projects", SelfSignup: "restricted", GroupLimit: 5})
+
+	got, err := client.UpdateGroupCategory(&GroupCategory{ID: 11, GroupLimit: 5}, "group_limit")
+	assert.Nil(t, err)
+	assert.Equal(t, "restricted", got.SelfSignup)
+
+	_, err = client.UpdateGroupCategory(&GroupCategory{ID: 11}, "limit")
+	assert.EqualError(t, err, `unknown fields to update: "limit"`)
+}
+
+func TestCanvasClient_AssignUnassignedMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/group_categories/11/assign_unassigned_members").
+		Reply(200).
+		BodyString(`{"id": 7, "context_id": 11, "context_type": "GroupCategory", "completion": 0, "workflow_state": "queued"}`)
+
+	got, err := client.AssignUnassignedMembers(11)
+	assert.Nil(t, err)
+	assert.Equal(t, "queued", got.WorkflowState)
+}
+
+func TestCanvasClient_ExportGroupCategory(t *testing.T) {
+	defer gock.Off()
+
+	csv := "name,canvas_user_id,group_name\nAda,8,Team 1\n"
+	gock.New(domain).
+		Get("/api/v1/group_categories/11/export").
+		Reply(200).
+		BodyString(csv)
+
+	got, err := client.ExportGroupCategory(11)
+	assert.Nil(t, err)
+	assert.Equal(t, csv, got)
+}
+
+func TestCanvasClient_ImportGroupCategory(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/group_categories/11/import").
+		AddMatcher(matchMultipart(url.Values{}, "attachment", "groups.csv", "canvas_user_id,group_name\n8,Team 1\n")).
+		Reply(200).
+		BodyString(`{"id": 8, "workflow_state": "running", "completion": 50}`)
+
+	got, err := client.ImportGroupCategory(11, &FileUpload{Name: "groups.csv", Content: strings.NewReader("canvas_user_id,group_name\n8,Team 1\n")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), got.ID)
+}
+
+func TestCanvasClient_UpdateGroup(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/groups/30").
+		AddMatcher(matchForm(url.Values{"join_level": {"invitation_only"}})).
+		Reply(200).
+		JSON(Group{ID: 30, Name: "Team 1", JoinLevel: "invitation_only"})
+
+	got, err := client.UpdateGroup(&Group{ID: 30, JoinLevel: "invitation_only"}, "join_level")
+	assert.Nil(t, err)
+	assert.Equal(t, "Team 1", got.Name)
+
+	_, err = client.UpdateGroup(&Group{ID: 30})
+	assert.EqualError(t, err, "no fields to update")
+}
+
+func TestCanvasClient_JoinGroup(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/groups/30/memberships").
+		AddMatcher(matchForm(url.Values{"user_id": {"self"}})).
+		Reply(200).
+		JSON(GroupMembership{ID: 2, GroupID: 30, UserID: 8, WorkflowState: "requested", JustCreated: true})
+
+	got, err := client.JoinGroup(30)
+	assert.Nil(t, err)
+	assert.Equal(t, "requested", got.WorkflowState)
+}
+
+func TestCanvasClient_GetGroupMemberships(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/groups/30/memberships").
+		MatchParam("filter_states[]", "invited").
+		Reply(200).
+		JSON([]GroupMembership{{ID: 2, GroupID: 30, UserID: 8, WorkflowState: "invited"}})
+
+	got, err := client.GetGroupMemberships(30, "invited")
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, int64(8), got[0].UserID)
+}
@@ -0,0 +1,486 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	icsDateTimeFormat    = "20060102T150405"
+	icsUTCDateTimeFormat = "20060102T150405Z"
+	icsDateFormat        = "20060102"
+	icsMaxLineOctets     = 75
+	icsDefaultProductID  = "-//arsenypoga//canvas-api//EN"
+)
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// ICSOptions is an interface for the generation of an iCalendar document
+type ICSOptions struct {
+	name     string
+	location *time.Location
+	alarms   []time.Duration
+	courses  map[int64]bool
+}
+
+// ICSOption is an adapter for generating options
+type ICSOption func(*ICSOptions)
+
+// WithICSName sets the name calendar applications show for the calendar
+func WithICSName(name string) ICSOption {
+	return func(ico *ICSOptions) {
+		ico.name = name
+	}
+}
+
+// WithICSTimeZone renders times in the location instead of UTC.
+// The location must be a named IANA zone such as "America/New_York",
+// time.Local has no name calendar clients know, so it is rendered in UTC
+func WithICSTimeZone(location *time.Location) ICSOption {
+	return func(ico *ICSOptions) {
+		ico.location = location
+	}
+}
+
+// WithICSAlarms adds a reminder to every event the given time before it starts
+func WithICSAlarms(before ...time.Duration) ICSOption {
+	return func(ico *ICSOptions) {
+		ico.alarms = append(ico.alarms, before...)
+	}
+}
+
+// WithICSCourses exports only the items that belong to the courses
+func WithICSCourses(courseIDs ...int64) ICSOption {
+	return func(ico *ICSOptions) {
+		if ico.courses == nil {
+			ico.courses = make(map[int64]bool)
+		}
+		for _, id := range courseIDs {
+			ico.courses[id] = true
+		}
+	}
+}
+
+// ICSCalendar collects assignments, calendar events and planner notes into an RFC 5545 iCalendar document
+type ICSCalendar struct {
+	domain  string
+	options ICSOptions
+	events  []icsEvent
+}
+
+// icsEvent is a single VEVENT of the document
+type icsEvent struct {
+	uid   string
+	stamp time.Time
+	start time.Time
+	// end is zero for events that happen at an instant, such as due dates
+	end         time.Time
+	allDay      bool
+	summary     string
+	description string
+	location    string
+	url         string
+}
+
+// NewICSCalendar creates new calendar for the items of the domain.
+// The domain makes the UIDs of the events globally unique
+func NewICSCalendar(domain string, setters ...ICSOption) *ICSCalendar {
+	args := ICSOptions{
+		location: time.UTC,
+	}
+	for _, setter := range setters {
+		setter(&args)
+	}
+
+	return &ICSCalendar{
+		domain:  domain,
+		options: args,
+	}
+}
+
+// includes reports whether items of the course are exported
+func (cal *ICSCalendar) includes(courseID int64) bool {
+	return cal.options.courses == nil || cal.options.courses[courseID]
+}
+
+// AddAssignments adds an event at the due date of every assignment, and one at the lock date
+// when the assignment locks after it is due. Assignments without dates are skipped
+func (cal *ICSCalendar) AddAssignments(assignments ...Assignment) error {
+	for _, a := range assignments {
+		if !cal.includes(a.CourseID) {
+			continue
+		}
+
+		stamp, err := parseICSStamp(a.UpdatedAt, a.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		dueAt, err := parseICSTime(a.DueAt)
+		if err != nil {
+			return err
+		}
+		lockAt, err := parseICSTime(a.LockAt)
+		if err != nil {
+			return err
+		}
+
+		if !dueAt.IsZero() {
+			cal.events = append(cal.events, icsEvent{
+				uid:         fmt.Sprintf("assignment-%d-due@%s", a.ID, cal.domain),
+				stamp:       stamp,
+				start:       dueAt,
+				summary:     "Due: " + a.Name,
+				description: plainText(a.Description),
+				url:         a.HTMLURL,
+			})
+		}
+
+		if !lockAt.IsZero() && !lockAt.Equal(dueAt) {
+			cal.events = append(cal.events, icsEvent{
+				uid:         fmt.Sprintf("assignment-%d-lock@%s", a.ID, cal.domain),
+				stamp:       stamp,
+				start:       lockAt,
+				summary:     "Closes: " + a.Name,
+				description: plainText(a.Description),
+				url:         a.HTMLURL,
+			})
+		}
+	}
+
+	return nil
+}
+
+// AddCalendarEvents adds the calendar events. Assignment events are added through their assignment
+func (cal *ICSCalendar) AddCalendarEvents(events ...CalendarEvent) error {
+	for _, e := range events {
+		if e.Assignment != nil {
+			if err := cal.AddAssignments(*e.Assignment); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !cal.includes(courseIDFromContextCode(e.ContextCode)) {
+			continue
+		}
+
+		stamp, err := parseICSStamp(e.UpdatedAt, e.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		start, err := parseICSTime(e.StartAt)
+		if err != nil {
+			return err
+		}
+		end, err := parseICSTime(e.EndAt)
+		if err != nil {
+			return err
+		}
+		if start.IsZero() {
+			continue
+		}
+		event := icsEvent{
+			uid:         fmt.Sprintf("calendar-event-%d@%s", e.ID, cal.domain),
+			stamp:       stamp,
+			start:       start,
+			end:         end,
+			summary:     e.Title,
+			description: plainText(e.Description),
+			location:    strings.TrimSpace(strings.Join([]string{e.LocationName, e.LocationAddress}, " ")),
+			url:         e.HTMLURL,
+		}
+
+		if e.AllDay && e.AllDayDate != "" {
+			day, err := time.Parse("2006-01-02", e.AllDayDate)
+			if err != nil {
+				return err
+			}
+			event.allDay = true
+			event.start = day
+			event.end = day.AddDate(0, 0, 1)
+		}
+
+		cal.events = append(cal.events, event)
+	}
+
+	return nil
+}
+
+// AddPlannerNotes adds an event at the todo date of every planner note
+func (cal *ICSCalendar) AddPlannerNotes(notes ...PlannerNote) error {
+	for _, n := range notes {
+		if !cal.includes(n.CourseID) {
+			continue
+		}
+
+		todoDate, err := parseICSTime(n.TodoDate)
+		if err != nil {
+			return err
+		}
+		if todoDate.IsZero() {
+			continue
+		}
+
+		cal.events = append(cal.events, icsEvent{
+			uid:         fmt.Sprintf("planner-note-%d@%s", n.ID, cal.domain),
+			stamp:       time.Now(),
+			start:       todoDate,
+			summary:     n.Title,
+			description: n.Description,
+			url:         n.LinkedObjectHTMLURL,
+		})
+	}
+
+	return nil
+}
+
+// String returns the iCalendar document
+func (cal *ICSCalendar) String() string {
+	b := bytes.Buffer{}
+	cal.WriteTo(&b)
+	return b.String()
+}
+
+// WriteTo writes the iCalendar document to w
+func (cal *ICSCalendar) WriteTo(w io.Writer) (int64, error) {
+	b := bytes.Buffer{}
+	line := func(name string, value string) {
+		writeICSLine(&b, name+":"+value)
+	}
+
+	events := make([]icsEvent, len(cal.events))
+	copy(events, cal.events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].start.Before(events[j].start)
+	})
+
+	location := cal.options.location
+	local := location.String() != "UTC" && location.String() != "Local"
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", icsDefaultProductID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.options.name != "" {
+		line("X-WR-CALNAME", escapeICSText(cal.options.name))
+	}
+	if local {
+		line("X-WR-TIMEZONE", location.String())
+		writeICSTimeZone(&b, location, events)
+	}
+
+	for _, e := range events {
+		line("BEGIN", "VEVENT")
+		line("UID", e.uid)
+		line("DTSTAMP", e.stamp.UTC().Format(icsUTCDateTimeFormat))
+		switch {
+		case e.allDay:
+			line("DTSTART;VALUE=DATE", e.start.Format(icsDateFormat))
+			line("DTEND;VALUE=DATE", e.end.Format(icsDateFormat))
+		case local:
+			tzid := "TZID=" + location.String()
+			line("DTSTART;"+tzid, e.start.In(location).Format(icsDateTimeFormat))
+			// DTEND must be after DTSTART, so events that happen at an instant leave it out
+			if e.end.After(e.start) {
+				line("DTEND;"+tzid, e.end.In(location).Format(icsDateTimeFormat))
+			}
+		default:
+			line("DTSTART", e.start.UTC().Format(icsUTCDateTimeFormat))
+			if e.end.After(e.start) {
+				line("DTEND", e.end.UTC().Format(icsUTCDateTimeFormat))
+			}
+		}
+		line("SUMMARY", escapeICSText(e.summary))
+		if e.description != "" {
+			line("DESCRIPTION", escapeICSText(e.description))
+		}
+		if e.location != "" {
+			line("LOCATION", escapeICSText(e.location))
+		}
+		if e.url != "" {
+			line("URL", e.url)
+		}
+		for _, before := range cal.options.alarms {
+			line("BEGIN", "VALARM")
+			line("ACTION", "DISPLAY")
+			line("DESCRIPTION", escapeICSText(e.summary))
+			line("TRIGGER", "-"+formatICSDuration(before))
+			line("END", "VALARM")
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return b.WriteTo(w)
+}
+
+// writeICSTimeZone writes the VTIMEZONE of the location with every offset transition
+// in the years spanned by the events
+func writeICSTimeZone(b *bytes.Buffer, location *time.Location, events []icsEvent) {
+	writeICSLine(b, "BEGIN:VTIMEZONE")
+	writeICSLine(b, "TZID:"+location.String())
+
+	firstYear, lastYear := time.Now().Year(), time.Now().Year()
+	if len(events) != 0 {
+		firstYear = events[0].start.In(location).Year()
+		lastYear = events[len(events)-1].start.In(location).Year()
+	}
+
+	start := time.Date(firstYear, time.January, 1, 0, 0, 0, 0, location)
+	end := time.Date(lastYear+1, time.January, 1, 0, 0, 0, 0, location)
+
+	transitions := 0
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		_, before := day.Zone()
+		_, after := next.Zone()
+		if before == after {
+			continue
+		}
+
+		at := findICSTransition(day, next)
+		writeICSTransition(b, at, before)
+		transitions++
+	}
+
+	if transitions == 0 {
+		name, offset := start.Zone()
+		writeICSLine(b, "BEGIN:STANDARD")
+		writeICSLine(b, "DTSTART:19700101T000000")
+		writeICSLine(b, "TZOFFSETFROM:"+formatICSOffset(offset))
+		writeICSLine(b, "TZOFFSETTO:"+formatICSOffset(offset))
+		writeICSLine(b, "TZNAME:"+name)
+		writeICSLine(b, "END:STANDARD")
+	}
+
+	writeICSLine(b, "END:VTIMEZONE")
+}
+
+// findICSTransition narrows down the moment between from and to when the offset of the zone changes
+func findICSTransition(from time.Time, to time.Time) time.Time {
+	_, offset := from.Zone()
+	low, high := from.Unix(), to.Unix()
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		if _, o := time.Unix(middle, 0).In(from.Location()).Zone(); o == offset {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return time.Unix(high, 0).In(from.Location())
+}
+
+func writeICSTransition(b *bytes.Buffer, at time.Time, offsetFrom int) {
+	name, offsetTo := at.Zone()
+
+	component := "STANDARD"
+	if offsetTo > offsetFrom {
+		component = "DAYLIGHT"
+	}
+
+	// DTSTART of a transition is the local time before the change
+	local := at.UTC().Add(time.Duration(offsetFrom) * time.Second)
+
+	writeICSLine(b, "BEGIN:"+component)
+	writeICSLine(b, "DTSTART:"+local.Format(icsDateTimeFormat))
+	writeICSLine(b, "TZOFFSETFROM:"+formatICSOffset(offsetFrom))
+	writeICSLine(b, "TZOFFSETTO:"+formatICSOffset(offsetTo))
+	writeICSLine(b, "TZNAME:"+name)
+	writeICSLine(b, "END:"+component)
+}
+
+// writeICSLine writes a content line, folding it so no line is longer than 75 octets
+func writeICSLine(b *bytes.Buffer, line string) {
+	limit := icsMaxLineOctets
+	for len(line) > limit {
+		cut := limit
+		// never split a multi-byte character
+		for cut > 0 && !isUTF8Start(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space that counts towards the limit
+		limit = icsMaxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isUTF8Start(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+// escapeICSText escapes a TEXT property value
+func escapeICSText(text string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(text)
+}
+
+// plainText strips the markup of an HTML description
+func plainText(description string) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(description, "")))
+}
+
+func formatICSOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+func formatICSDuration(d time.Duration) string {
+	minutes := int64(d / time.Minute)
+	if minutes%(24*60) == 0 && minutes != 0 {
+		return fmt.Sprintf("P%dD", minutes/(24*60))
+	}
+	return fmt.Sprintf("PT%dM", minutes)
+}
+
+// parseICSTime parses a canvas timestamp, returning the zero time for empty values
+func parseICSTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseICSStamp returns the first of the timestamps that is set, or the current time
+func parseICSStamp(values ...string) (time.Time, error) {
+	for _, value := range values {
+		if value != "" {
+			return time.Parse(time.RFC3339, value)
+		}
+	}
+	return time.Now(), nil
+}
+
+// courseIDFromContextCode returns the course id of a "course_123" context code, or 0 for other contexts
+func courseIDFromContextCode(contextCode string) int64 {
+	if !strings.HasPrefix(contextCode, "course_") {
+		return 0
+	}
+	id, _ := strconv.ParseInt(strings.TrimPrefix(contextCode, "course_"), 10, 64)
+	return id
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestICSCalendar_String(t *testing.T) {
+	cal := NewICSCalendar("domain.instructure.com",
+		WithICSName("Writing, Spring"),
+		WithICSAlarms(30*time.Minute, 24*time.Hour),
+		WithICSCourses(5),
+	)
+
+	err := cal.AddAssignments(
+		Assignment{
+			ID:          20,
+			CourseID:    5,
+			Name:        "Essay; draft",
+			Description: "<p>Write &amp; submit</p>",
+			DueAt:       "2021-03-02T05:59:59Z",
+			LockAt:      "2021-03-05T05:59:59Z",
+			UpdatedAt:   "2021-02-01T00:00:00Z",
+		},
+		Assignment{ID: 21, CourseID: 6, Name: "Other course", DueAt: "2021-03-02T05:59:59Z"},
+	)
+	assert.Nil(t, err)
+
+	err = cal.AddCalendarEvents(CalendarEvent{
+		ID:          7,
+		ContextCode: "course_5",
+		Title:       "Review day",
+		AllDay:      true,
+		AllDayDate:  "2021-03-01",
+		StartAt:     "2021-03-01T06:00:00Z",
+		UpdatedAt:   "2021-02-01T00:00:00Z",
+	})
+	assert.Nil(t, err)
+
+	got := cal.String()
+	lines := strings.Split(got, "\r\n")
+
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-2])
+	assert.Contains(t, got, "X-WR-CALNAME:Writing\\, Spring\r\n")
+	assert.Contains(t, got, "UID:assignment-20-due@domain.instructure.com\r\n")
+	assert.Contains(t, got, "UID:assignment-20-lock@domain.instructure.com\r\n")
+	assert.Contains(t, got, "SUMMARY:Due: Essay\\; draft\r\n")
+	assert.Contains(t, got, "DESCRIPTION:Write & submit\r\n")
+	assert.Contains(t, got, "DTSTART:20210302T055959Z\r\n")
+	assert.Contains(t, got, "DTSTART;VALUE=DATE:20210301\r\n")
+	assert.Contains(t, got, "DTEND;VALUE=DATE:20210302\r\n")
+	assert.Contains(t, got, "DTSTART:20210302T055959Z\r\nSUMMARY:Due: Essay\\; draft\r\n", "due dates have no DTEND")
+	assert.Contains(t, got, "TRIGGER:-PT30M\r\n")
+	assert.Contains(t, got, "TRIGGER:-P1D\r\n")
+	assert.NotContains(t, got, "Other course")
+
+	// output is stable between renders
+	assert.Equal(t, got, cal.String())
+}
+
+func TestICSCalendar_TimeZone(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+
+	cal := NewICSCalendar("domain.instructure.com", WithICSTimeZone(location))
+	err = cal.AddAssignments(Assignment{ID: 20, Name: "Essay", DueAt: "2021-03-02T05:59:59Z"})
+	assert.Nil(t, err)
+
+	got := cal.String()
+	assert.Contains(t, got, "BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n")
+	assert.Contains(t, got, "BEGIN:DAYLIGHT\r\nDTSTART:20210314T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\n")
+	assert.Contains(t, got, "BEGIN:STANDARD\r\nDTSTART:20211107T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\n")
+	assert.Contains(t, got, "DTSTART;TZID=America/New_York:20210302T005959\r\n")
+}
+
+func TestICSCalendar_LoadedUTC(t *testing.T) {
+	location, err := time.LoadLocation("UTC")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+
+	cal := NewICSCalendar("domain.instructure.com", WithICSTimeZone(location))
+	err = cal.AddCalendarEvents(CalendarEvent{ID: 7, Title: "Lab", StartAt: "2021-03-02T15:00:00Z", EndAt: "2021-03-02T16:00:00Z"})
+	assert.Nil(t, err)
+
+	got := cal.String()
+	assert.NotContains(t, got, "VTIMEZONE")
+	assert.Contains(t, got, "DTSTART:20210302T150000Z\r\nDTEND:20210302T160000Z\r\n")
+}
+
+func TestICSCalendar_Local(t *testing.T) {
+	cal := NewICSCalendar("domain.instructure.com", WithICSTimeZone(time.Local))
+	err := cal.AddCalendarEvents(CalendarEvent{ID: 7, Title: "Lab", StartAt: "2021-03-02T15:00:00Z", EndAt: "2021-03-02T16:00:00Z"})
+	assert.Nil(t, err)
+
+	got := cal.String()
+	assert.NotContains(t, got, "Local")
+	assert.NotContains(t, got, "VTIMEZONE")
+	assert.Contains(t, got, "DTSTART:20210302T150000Z\r\nDTEND:20210302T160000Z\r\n")
+}
+
+func TestWriteICSLine_Folding(t *testing.T) {
+	cal := NewICSCalendar("domain")
+	cal.events = append(cal.events, icsEvent{uid: "x", summary: strings.Repeat("é", 60)})
+
+	for _, line := range strings.Split(cal.String(), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// LatePolicy is the policy that deducts points from late and missing submissions of a course
+type LatePolicy struct {
+	ID                                int64 `json:"id"`
+	CourseID                          int64 `json:"course_id"`
+	MissingSubmissionDeductionEnabled bool  `json:"missing_submission_deduction_enabled"`
+	// MissingSubmissionDeduction is the percentage deducted from missing submissions
+	MissingSubmissionDeduction     float64 `json:"missing_submission_deduction"`
+	LateSubmissionDeductionEnabled bool    `json:"late_submission_deduction_enabled"`
+	// LateSubmissionDeduction is the percentage deducted from late submissions for every LateSubmissionInterval
+	LateSubmissionDeduction float64 `json:"late_submission_deduction"`
+	// LateSubmissionInterval is "day" or "hour"
+	LateSubmissionInterval              string `json:"late_submission_interval"`
+	LateSubmissionMinimumPercentEnabled bool   `json:"late_submission_minimum_percent_enabled"`
+	// LateSubmissionMinimumPercent is the lowest percentage late deductions can bring a submission down to
+	LateSubmissionMinimumPercent float64 `json:"late_submission_minimum_percent"`
+	CreatedAt                    string  `json:"created_at"`
+	UpdatedAt                    string  `json:"updated_at"`
+}
+
+// latePolicyResponse is the envelope canvas wraps a late policy in
+type latePolicyResponse struct {
+	LatePolicy LatePolicy `json:"late_policy"`
+}
+
+// GetLatePolicy returns the late policy of the course
+func (c *CanvasClient) GetLatePolicy(courseID int64) (*LatePolicy, error) {
+	r := latePolicyResponse{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/late_policy", c.ClientURL(), courseID)
+	err := c.getJSON(requestURL, &r)
+
+	if err != nil {
+		return &r.LatePolicy, err
+	}
+
+	return &r.LatePolicy, nil
+}
+
+// CreateLatePolicy creates the late policy of a course that has none
+func (c *CanvasClient) CreateLatePolicy(courseID int64, policy *LatePolicy) (*LatePolicy, error) {
+	r := latePolicyResponse{}
+
+	form, err := latePolicyForm(policy, nil)
+
+	if err != nil {
+		return &r.LatePolicy, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/late_policy", c.ClientURL(), courseID)
+	err = c.sendJSON("POST", requestURL, form, &r)
+
+	if err != nil {
+		return &r.LatePolicy, err
+	}
+
+	return &r.LatePolicy, nil
+}
+
+// UpdateLatePolicy updates the named fields of the late policy of the course,
+// such as "late_submission_deduction" or "late_submission_interval", to match the given one
+func (c *CanvasClient) UpdateLatePolicy(courseID int64, policy *LatePolicy, fields ...string) error {
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return err
+	}
+
+	form, err := latePolicyForm(policy, f)
+
+	if err != nil {
+		return err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/late_policy", c.ClientURL(), courseID)
+
+	return c.sendJSON("PATCH", requestURL, form, nil)
+}
+
+func latePolicyForm(policy *LatePolicy, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("missing_submission_deduction_enabled", true) {
+		form.Add("late_policy[missing_submission_deduction_enabled]", strconv.FormatBool(policy.MissingSubmissionDeductionEnabled))
+	}
+	if fields.has("missing_submission_deduction", true) {
+		form.Add("late_policy[missing_submission_deduction]", strconv.FormatFloat(policy.MissingSubmissionDeduction, 'f', -1, 64))
+	}
+	if fields.has("late_submission_deduction_enabled", true) {
+		form.Add("late_policy[late_submission_deduction_enabled]", strconv.FormatBool(policy.LateSubmissionDeductionEnabled))
+	}
+	if fields.has("late_submission_deduction", true) {
+		form.Add("late_policy[late_submission_deduction]", strconv.FormatFloat(policy.LateSubmissionDeduction, 'f', -1, 64))
+	}
+	if fields.has("late_submission_interval", policy.LateSubmissionInterval != "") {
+		form.Add("late_policy[late_submission_interval]", policy.LateSubmissionInterval)
+	}
+	if fields.has("late_submission_minimum_percent_enabled", true) {
+		form.Add("late_policy[late_submission_minimum_percent_enabled]", strconv.FormatBool(policy.LateSubmissionMinimumPercentEnabled))
+	}
+	if fields.has("late_submission_minimum_percent", true) {
+		form.Add("late_policy[late_submission_minimum_percent]", strconv.FormatFloat(policy.LateSubmissionMinimumPercent, 'f', -1, 64))
+	}
+
+	return form, fields.unknown()
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetLatePolicy(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/late_policy").
+		Reply(200).
+		BodyString(`{"late_policy": {"id": 1, "course_id": 5, "late_submission_deduction_enabled": true,
+			"late_submission_deduction": 10, "late_submission_interval": "day"}}`)
+
+	got, err := client.GetLatePolicy(5)
+	assert.Nil(t, err)
+	assert.Equal(t, 10.0, got.LateSubmissionDeduction)
+	assert.Equal(t, "day", got.LateSubmissionInterval)
+}
+
+func TestCanvasClient_UpdateLatePolicy(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Patch("/api/v1/courses/5/late_policy").
+		AddMatcher(matchForm(url.Values{
+			"late_policy[missing_submission_deduction_enabled]": {"true"},
+			"late_policy[missing_submission_deduction]":         {"100"},
+		})).
+		Reply(204)
+
+	err := client.UpdateLatePolicy(5, &LatePolicy{MissingSubmissionDeductionEnabled: true, MissingSubmissionDeduction: 100},
+		"missing_submission_deduction_enabled", "missing_submission_deduction")
+	assert.Nil(t, err)
+
+	err = client.UpdateLatePolicy(5, &LatePolicy{})
+	assert.EqualError(t, err, "no fields to update")
+
+	err = client.UpdateLatePolicy(5, &LatePolicy{}, "missing_deduction")
+	assert.EqualError(t, err, `unknown fields to update: "missing_deduction"`)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ProvisionalGrade is a grade a grader gave to a submission of a moderated assignment before it is published
+type ProvisionalGrade struct {
+	ID                            int64   `json:"provisional_grade_id"`
+	Score                         float64 `json:"score"`
+	Grade                         string  `json:"grade"`
+	GradeMatchesCurrentSubmission bool    `json:"grade_matches_current_submission"`
+	GradedAt                      string  `json:"graded_at"`
+	// Final marks the grade given by the moderator
+	Final          bool   `json:"final"`
+	SpeedgraderURL string `json:"speedgrader_url"`
+}
+
+// GradeableStudent is a student that can be graded for an assignment.
+// The moderation fields are only set for moderated assignments
+type GradeableStudent struct {
+	ID                         int64              `json:"id"`
+	DisplayName                string             `json:"display_name"`
+	AvatarImageURL             string             `json:"avatar_image_url"`
+	HTMLURL                    string             `json:"html_url"`
+	InModerationSet            bool               `json:"in_moderation_set"`
+	SelectedProvisionalGradeID int64              `json:"selected_provisional_grade_id"`
+	ProvisionalGrades          []ProvisionalGrade `json:"provisional_grades"`
+}
+
+// ProvisionalGradeSelection is the provisional grade that is published for a student
+type ProvisionalGradeSelection struct {
+	AssignmentID               int64 `json:"assignment_id"`
+	StudentID                  int64 `json:"student_id"`
+	SelectedProvisionalGradeID int64 `json:"selected_provisional_grade_id"`
+}
+
+// GetModerationSet returns the students of the moderated assignment whose submissions get a second review
+func (c *CanvasClient) GetModerationSet(courseID int64, assignmentID int64) ([]User, error) {
+	u := make([]User, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/moderated_students", c.ClientURL(), courseID, assignmentID)
+	err := c.getPaginatedJSON(requestURL, &u)
+
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// AddToModerationSet adds the students with the given studentIDs to the moderation set of the assignment
+// and returns the students that were added
+func (c *CanvasClient) AddToModerationSet(courseID int64, assignmentID int64, studentIDs ...int64) ([]User, error) {
+	u := make([]User, 0)
+
+	form := url.Values{}
+	for _, id := range studentIDs {
+		form.Add("student_ids[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/moderated_students", c.ClientURL(), courseID, assignmentID)
+	err := c.sendJSON("POST", requestURL, form, &u)
+
+	if err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
+
+// GetGradeableStudents returns the students that can be graded for the assignment,
+// along with their provisional grades when the assignment is moderated
+func (c *CanvasClient) GetGradeableStudents(courseID int64, assignmentID int64) ([]GradeableStudent, error) {
+	s := make([]GradeableStudent, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/gradeable_students", c.ClientURL(), courseID, assignmentID)
+	err := c.getPaginatedJSON(requestURL, &s)
+
+	if err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// NeedsProvisionalGrade reports whether the submission of the student still needs a provisional grade from the user
+func (c *CanvasClient) NeedsProvisionalGrade(courseID int64, assignmentID int64, studentID int64) (bool, error) {
+	r := struct {
+		NeedsProvisionalGrade bool `json:"needs_provisional_grade"`
+	}{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/provisional_grades/status?student_id=%d",
+		c.ClientURL(), courseID, assignmentID, studentID)
+	err := c.getJSON(requestURL, &r)
+
+	if err != nil {
+		return false, err
+	}
+
+	return r.NeedsProvisionalGrade, nil
+}
+
+// SelectProvisionalGrade chooses the provisional grade with the given gradeID as the one that is published for its student
+func (c *CanvasClient) SelectProvisionalGrade(courseID int64, assignmentID int64, gradeID int64) (*ProvisionalGradeSelection, error) {
+	s := ProvisionalGradeSelection{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/provisional_grades/%d/select",
+		c.ClientURL(), courseID, assignmentID, gradeID)
+	err := c.sendJSON("PUT", requestURL, nil, &s)
+
+	if err != nil {
+		return &s, err
+	}
+
+	return &s, nil
+}
+
+// BulkSelectProvisionalGrades chooses the provisional grades with the given gradeIDs, at most one per student,
+// as the ones that are published
+func (c *CanvasClient) BulkSelectProvisionalGrades(courseID int64, assignmentID int64, gradeIDs ...int64) ([]ProvisionalGradeSelection, error) {
+	s := make([]ProvisionalGradeSelection, 0)
+
+	form := url.Values{}
+	for _, id := range gradeIDs {
+		form.Add("provisional_grade_ids[]", strconv.FormatInt(id, 10))
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/provisional_grades/bulk_select", c.ClientURL(), courseID, assignmentID)
+	err := c.sendJSON("PUT", requestURL, form, &s)
+
+	if err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// PublishProvisionalGrades publishes the selected provisional grades of the assignment as the grades of its submissions.
+// Grades can no longer be moderated once they are published
+func (c *CanvasClient) PublishProvisionalGrades(courseID int64, assignmentID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/provisional_grades/publish", c.ClientURL(), courseID, assignmentID)
+
+	return c.sendJSON("POST", requestURL, nil, nil)
+}
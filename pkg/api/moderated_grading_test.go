@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_AddToModerationSet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/assignments/40/moderated_students").
+		AddMatcher(matchForm(url.Values{"student_ids[]": {"8", "9"}})).
+		Reply(200).
+		JSON([]User{{ID: 8, Name: "Ada"}, {ID: 9, Name: "Grace"}})
+
+	got, err := client.AddToModerationSet(5, 40, 8, 9)
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestCanvasClient_GetGradeableStudents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/assignments/40/gradeable_students").
+		Reply(200).
+		BodyString(`[{"id": 8, "display_name": "Ada", "in_moderation_set": true, "selected_provisional_grade_id": 31,
+			"provisional_grades": [{"provisional_grade_id": 31, "score": 9, "grade": "9", "final": false}]}]`)
+
+	got, err := client.GetGradeableStudents(5, 40)
+	assert.Nil(t, err)
+	assert.True(t, got[0].InModerationSet)
+	assert.Equal(t, int64(31), got[0].ProvisionalGrades[0].ID)
+}
+
+func TestCanvasClient_BulkSelectProvisionalGrades(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/assignments/40/provisional_grades/bulk_select").
+		AddMatcher(matchForm(url.Values{"provisional_grade_ids[]": {"31", "32"}})).
+		Reply(200).
+		JSON([]ProvisionalGradeSelection{{AssignmentID: 40, StudentID: 8, SelectedProvisionalGradeID: 31}})
+
+	got, err := client.BulkSelectProvisionalGrades(5, 40, 31, 32)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(31), got[0].SelectedProvisionalGradeID)
+}
+
+func TestCanvasClient_NeedsProvisionalGrade(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/assignments/40/provisional_grades/status").
+		MatchParam("student_id", "8").
+		Reply(200).
+		BodyString(`{"needs_provisional_grade": true}`)
+
+	got, err := client.NeedsProvisionalGrade(5, 40, 8)
+	assert.Nil(t, err)
+	assert.True(t, got)
+}
+
+func TestCanvasClient_GetAnonymousSubmission(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/assignments/40/anonymous_submissions/aB3dE").
+		Reply(200).
+		BodyString(`{"id": 70, "assignment_id": 40, "anonymous_id": "aB3dE", "workflow_state": "submitted"}`)
+
+	got, err := client.GetAnonymousSubmission(5, 40, "aB3dE")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(70), got.ID)
+	assert.Zero(t, got.UserID)
+}
+
+func TestCanvasClient_PostAssignmentGrades(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/graphql").
+		MatchType("json").
+		BodyString(`"input":\{"assignmentId":"40","gradedOnly":true,"sectionIds":\["3"\]\}`).
+		Reply(200).
+		BodyString(`{"data": {"result": {"progress": {"_id": "15", "state": "queued", "completion": 0}, "errors": null}}}`)
+
+	got, err := client.PostAssignmentGrades(40, true, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), got.ID)
+	assert.Equal(t, "queued", got.WorkflowState)
+}
+
+func TestCanvasClient_SetAssignmentPostPolicy_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/graphql").
+		Reply(200).
+		BodyString(`{"data": {"result": {"errors": [{"message": "not found"}]}}}`)
+
+	err := client.SetAssignmentPostPolicy(40, true)
+	assert.EqualError(t, err, "graphql: not found")
+}
@@ -0,0 +1,472 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Module is a module of a course
+type Module struct {
+	ID                        int64   `json:"id"`
+	WorkflowState             string  `json:"workflow_state"`
+	Position                  int64   `json:"position"`
+	Name                      string  `json:"name"`
+	UnlockAt                  string  `json:"unlock_at"`
+	RequireSequentialProgress bool    `json:"require_sequential_progress"`
+	PrerequisiteModuleIDs     []int64 `json:"prerequisite_module_ids"`
+	ItemsCount                int64   `json:"items_count"`
+	ItemsURL                  string  `json:"items_url"`
+	// Items is only set when the items are included and the module does not have too many of them
+	Items []ModuleItem `json:"items"`
+	// State is the progress of the student: "locked", "unlocked", "started" or "completed"
+	State             string `json:"state"`
+	CompletedAt       string `json:"completed_at"`
+	PublishFinalGrade bool   `json:"publish_final_grade"`
+	Published         bool   `json:"published"`
+}
+
+// ModuleItem is an item of a module
+type ModuleItem struct {
+	ID       int64  `json:"id"`
+	ModuleID int64  `json:"module_id"`
+	Position int64  `json:"position"`
+	Title    string `json:"title"`
+	Indent   int64  `json:"indent"`
+	// Type is one of "File", "Page", "Discussion", "Assignment", "Quiz", "SubHeader", "ExternalUrl" or "ExternalTool"
+	Type                  string                 `json:"type"`
+	ContentID             int64                  `json:"content_id"`
+	HTMLURL               string                 `json:"html_url"`
+	URL                   string                 `json:"url"`
+	PageURL               string                 `json:"page_url"`
+	ExternalURL           string                 `json:"external_url"`
+	NewTab                bool                   `json:"new_tab"`
+	CompletionRequirement *CompletionRequirement `json:"completion_requirement"`
+	ContentDetails        *ContentDetails        `json:"content_details"`
+	Published             bool                   `json:"published"`
+}
+
+// CompletionRequirement is what a student has to do to complete a module item
+type CompletionRequirement struct {
+	// Type is one of "must_view", "must_contribute", "must_submit", "must_mark_done" or "min_score"
+	Type     string  `json:"type"`
+	MinScore float64 `json:"min_score,omitempty"`
+	// Completed is only set when the progress of a student is requested
+	Completed bool `json:"completed"`
+}
+
+// ContentDetails are details of the content of a module item
+type ContentDetails struct {
+	PointsPossible  float64 `json:"points_possible"`
+	DueAt           string  `json:"due_at"`
+	UnlockAt        string  `json:"unlock_at"`
+	LockAt          string  `json:"lock_at"`
+	LockedForUser   bool    `json:"locked_for_user"`
+	LockExplanation string  `json:"lock_explanation"`
+}
+
+// ModuleItemSequence is the position of an asset in the modules of a course
+type ModuleItemSequence struct {
+	Items   []ModuleItemSequenceNode `json:"items"`
+	Modules []Module                 `json:"modules"`
+}
+
+// ModuleItemSequenceNode is an occurrence of an asset in a module with the items around it
+type ModuleItemSequenceNode struct {
+	Prev    *ModuleItem `json:"prev"`
+	Current *ModuleItem `json:"current"`
+	Next    *ModuleItem `json:"next"`
+}
+
+// ModulesOptions is an interface for the lookup of modules and module items
+type ModulesOptions struct {
+	items          bool
+	contentDetails bool
+	searchTerm     string
+	studentID      int64
+}
+
+// ModulesOption is an adapter for generating options
+type ModulesOption func(*ModulesOptions)
+
+// WithModuleItems includes the items of the modules
+func WithModuleItems() ModulesOption {
+	return func(mo *ModulesOptions) {
+		mo.items = true
+	}
+}
+
+// WithModuleContentDetails includes the due dates, points and lock state of the items
+func WithModuleContentDetails() ModulesOption {
+	return func(mo *ModulesOptions) {
+		mo.contentDetails = true
+	}
+}
+
+// WithModuleSearchTerm limits the modules or items to those whose name matches the search term
+func WithModuleSearchTerm(searchTerm string) ModulesOption {
+	return func(mo *ModulesOptions) {
+		mo.searchTerm = searchTerm
+	}
+}
+
+// WithStudentProgress returns the state and completed requirements of the student with the given studentID
+func WithStudentProgress(studentID int64) ModulesOption {
+	return func(mo *ModulesOptions) {
+		mo.studentID = studentID
+	}
+}
+
+// modulesURL builds the URL of a modules endpoint of the course with the options applied
+func (c *CanvasClient) modulesURL(courseID int64, path string, setters []ModulesOption) (string, error) {
+	args := &ModulesOptions{}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/courses/%d/modules%s", c.ClientURL(), courseID, path))
+
+	if err != nil {
+		return "", err
+	}
+
+	q := parsedURL.Query()
+
+	if args.items {
+		q.Add("include[]", "items")
+	}
+	if args.contentDetails {
+		q.Add("include[]", "content_details")
+	}
+	if args.searchTerm != "" {
+		q.Add("search_term", args.searchTerm)
+	}
+	if args.studentID != 0 {
+		q.Add("student_id", strconv.FormatInt(args.studentID, 10))
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	return parsedURL.String(), nil
+}
+
+// GetModules returns the modules of a course
+func (c *CanvasClient) GetModules(courseID int64, setters ...ModulesOption) ([]Module, error) {
+	m := make([]Module, 0)
+
+	requestURL, err := c.modulesURL(courseID, "", setters)
+
+	if err != nil {
+		return m, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &m)
+
+	if err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// GetModule returns the module with the given moduleID
+func (c *CanvasClient) GetModule(courseID int64, moduleID int64, setters ...ModulesOption) (*Module, error) {
+	m := Module{}
+
+	requestURL, err := c.modulesURL(courseID, fmt.Sprintf("/%d", moduleID), setters)
+
+	if err != nil {
+		return &m, err
+	}
+
+	err = c.getJSON(requestURL, &m)
+
+	if err != nil {
+		return &m, err
+	}
+
+	return &m, nil
+}
+
+// CreateModule creates a module in the course
+func (c *CanvasClient) CreateModule(courseID int64, module *Module) (*Module, error) {
+	created := Module{}
+
+	form, err := moduleForm(module, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules", c.ClientURL(), courseID)
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateModule updates the named fields of the module, such as "name" or "published", to match the given one
+func (c *CanvasClient) UpdateModule(courseID int64, module *Module, fields ...string) (*Module, error) {
+	updated := Module{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := moduleForm(module, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d", c.ClientURL(), courseID, module.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteModule deletes the module with the given moduleID and returns it
+func (c *CanvasClient) DeleteModule(courseID int64, moduleID int64) (*Module, error) {
+	deleted := Module{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d", c.ClientURL(), courseID, moduleID)
+	err := c.sendJSON("DELETE", requestURL, nil, &deleted)
+
+	if err != nil {
+		return &deleted, err
+	}
+
+	return &deleted, nil
+}
+
+// RelockModule resets the progress of every student through the module,
+// so that changed requirements apply to students that already completed it
+func (c *CanvasClient) RelockModule(courseID int64, moduleID int64) (*Module, error) {
+	m := Module{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/relock", c.ClientURL(), courseID, moduleID)
+	err := c.sendJSON("PUT", requestURL, nil, &m)
+
+	if err != nil {
+		return &m, err
+	}
+
+	return &m, nil
+}
+
+func moduleForm(module *Module, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("name", true) {
+		form.Add("module[name]", module.Name)
+	}
+	if fields.has("require_sequential_progress", true) {
+		form.Add("module[require_sequential_progress]", strconv.FormatBool(module.RequireSequentialProgress))
+	}
+	if fields.has("publish_final_grade", true) {
+		form.Add("module[publish_final_grade]", strconv.FormatBool(module.PublishFinalGrade))
+	}
+	if fields.has("unlock_at", module.UnlockAt != "") {
+		form.Add("module[unlock_at]", module.UnlockAt)
+	}
+	if fields.has("position", module.Position != 0) {
+		form.Add("module[position]", strconv.FormatInt(module.Position, 10))
+	}
+	if fields.has("prerequisite_module_ids", len(module.PrerequisiteModuleIDs) != 0) {
+		if len(module.PrerequisiteModuleIDs) == 0 {
+			// an empty value clears the prerequisites of an existing module
+			form.Add("module[prerequisite_module_ids][]", "")
+		}
+		for _, id := range module.PrerequisiteModuleIDs {
+			form.Add("module[prerequisite_module_ids][]", strconv.FormatInt(id, 10))
+		}
+	}
+	if fields.has("published", false) {
+		form.Add("module[published]", strconv.FormatBool(module.Published))
+	}
+
+	return form, fields.unknown()
+}
+
+// GetModuleItems returns the items of the module
+func (c *CanvasClient) GetModuleItems(courseID int64, moduleID int64, setters ...ModulesOption) ([]ModuleItem, error) {
+	items := make([]ModuleItem, 0)
+
+	requestURL, err := c.modulesURL(courseID, fmt.Sprintf("/%d/items", moduleID), setters)
+
+	if err != nil {
+		return items, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &items)
+
+	if err != nil {
+		return items, err
+	}
+
+	return items, nil
+}
+
+// GetModuleItem returns the module item with the given itemID
+func (c *CanvasClient) GetModuleItem(courseID int64, moduleID int64, itemID int64, setters ...ModulesOption) (*ModuleItem, error) {
+	item := ModuleItem{}
+
+	requestURL, err := c.modulesURL(courseID, fmt.Sprintf("/%d/items/%d", moduleID, itemID), setters)
+
+	if err != nil {
+		return &item, err
+	}
+
+	err = c.getJSON(requestURL, &item)
+
+	if err != nil {
+		return &item, err
+	}
+
+	return &item, nil
+}
+
+// CreateModuleItem adds the item to the module
+func (c *CanvasClient) CreateModuleItem(courseID int64, moduleID int64, item *ModuleItem) (*ModuleItem, error) {
+	created := ModuleItem{}
+
+	form, err := moduleItemForm(item, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	form.Add("module_item[type]", item.Type)
+	if item.ContentID != 0 {
+		form.Add("module_item[content_id]", strconv.FormatInt(item.ContentID, 10))
+	}
+	if item.PageURL != "" {
+		form.Add("module_item[page_url]", item.PageURL)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/items", c.ClientURL(), courseID, moduleID)
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateModuleItem updates the named fields of the item, such as "title" or "published", to match the given one.
+// Updating "module_id" moves the item to the module with the ModuleID of the item
+func (c *CanvasClient) UpdateModuleItem(courseID int64, moduleID int64, item *ModuleItem, fields ...string) (*ModuleItem, error) {
+	updated := ModuleItem{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := moduleItemForm(item, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/items/%d", c.ClientURL(), courseID, moduleID, item.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteModuleItem removes the item with the given itemID from the module and returns it
+func (c *CanvasClient) DeleteModuleItem(courseID int64, moduleID int64, itemID int64) (*ModuleItem, error) {
+	deleted := ModuleItem{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/items/%d", c.ClientURL(), courseID, moduleID, itemID)
+	err := c.sendJSON("DELETE", requestURL, nil, &deleted)
+
+	if err != nil {
+		return &deleted, err
+	}
+
+	return &deleted, nil
+}
+
+func moduleItemForm(item *ModuleItem, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", item.Title != "") {
+		form.Add("module_item[title]", item.Title)
+	}
+	if fields.has("position", item.Position != 0) {
+		form.Add("module_item[position]", strconv.FormatInt(item.Position, 10))
+	}
+	if fields.has("indent", true) {
+		form.Add("module_item[indent]", strconv.FormatInt(item.Indent, 10))
+	}
+	if fields.has("external_url", item.ExternalURL != "") {
+		form.Add("module_item[external_url]", item.ExternalURL)
+	}
+	if fields.has("new_tab", true) {
+		form.Add("module_item[new_tab]", strconv.FormatBool(item.NewTab))
+	}
+	if fields.has("published", false) {
+		form.Add("module_item[published]", strconv.FormatBool(item.Published))
+	}
+	if fields.has("module_id", false) {
+		form.Add("module_item[module_id]", strconv.FormatInt(item.ModuleID, 10))
+	}
+	if r := item.CompletionRequirement; fields.has("completion_requirement", true) && r != nil {
+		form.Add("module_item[completion_requirement][type]", r.Type)
+		if r.Type == "min_score" {
+			form.Add("module_item[completion_requirement][min_score]", strconv.FormatFloat(r.MinScore, 'f', -1, 64))
+		}
+	}
+
+	return form, fields.unknown()
+}
+
+// MarkModuleItemDone marks an item with a "must_mark_done" requirement as done or not done
+func (c *CanvasClient) MarkModuleItemDone(courseID int64, moduleID int64, itemID int64, done bool) error {
+	method := "DELETE"
+	if done {
+		method = "PUT"
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/items/%d/done", c.ClientURL(), courseID, moduleID, itemID)
+
+	return c.sendJSON(method, requestURL, nil, nil)
+}
+
+// MarkModuleItemRead fulfills the "must_view" requirement of the item without viewing it,
+// for items such as external tools whose views canvas cannot see
+func (c *CanvasClient) MarkModuleItemRead(courseID int64, moduleID int64, itemID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/modules/%d/items/%d/mark_read", c.ClientURL(), courseID, moduleID, itemID)
+
+	return c.sendJSON("POST", requestURL, nil, nil)
+}
+
+// GetModuleItemSequence returns the items before and after the asset in the modules of the course.
+// AssetType is one of "ModuleItem", "File", "Page", "Discussion", "Quiz" or "Assignment"
+func (c *CanvasClient) GetModuleItemSequence(courseID int64, assetType string, assetID int64) (*ModuleItemSequence, error) {
+	s := ModuleItemSequence{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/module_item_sequence?asset_type=%s&asset_id=%d", c.ClientURL(), courseID, url.QueryEscape(assetType), assetID)
+	err := c.getJSON(requestURL, &s)
+
+	if err != nil {
+		return &s, err
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetModules(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/modules").
+		MatchParam("include[]", "items").
+		MatchParam("student_id", "8").
+		Reply(200).
+		BodyString(`[{"id": 1, "name": "Week 1", "state": "started", "prerequisite_module_ids": [],
+			"items": [{"id": 10, "module_id": 1, "type": "Assignment", "content_id": 40,
+				"completion_requirement": {"type": "min_score", "min_score": 7, "completed": false}}]}]`)
+
+	got, err := client.GetModules(5, WithModuleItems(), WithStudentProgress(8))
+	assert.Nil(t, err)
+	assert.Equal(t, "started", got[0].State)
+	assert.Equal(t, &CompletionRequirement{Type: "min_score", MinScore: 7}, got[0].Items[0].CompletionRequirement)
+}
+
+func TestCanvasClient_CreateModule(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/modules").
+		AddMatcher(matchForm(url.Values{
+			"module[name]":                        {"Week 3"},
+			"module[require_sequential_progress]": {"true"},
+			"module[publish_final_grade]":         {"false"},
+			"module[prerequisite_module_ids][]":   {"1", "2"},
+		})).
+		Reply(200).
+		JSON(Module{ID: 3, Name: "Week 3", PrerequisiteModuleIDs: []int64{1, 2}})
+
+	got, err := client.CreateModule(5, &Module{Name: "Week 3", RequireSequentialProgress: true, PrerequisiteModuleIDs: []int64{1, 2}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), got.ID)
+}
+
+func TestCanvasClient_UpdateModule(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/modules/1").
+		AddMatcher(matchForm(url.Values{"module[prerequisite_module_ids][]": {""}})).
+		Reply(200).
+		JSON(Module{ID: 1, Name: "Week 1"})
+
+	got, err := client.UpdateModule(5, &Module{ID: 1}, "prerequisite_module_ids")
+	assert.Nil(t, err)
+	assert.Equal(t, "Week 1", got.Name)
+
+	_, err = client.UpdateModule(5, &Module{ID: 1}, "prerequisites")
+	assert.EqualError(t, err, `unknown fields to update: "prerequisites"`)
+}
+
+func TestCanvasClient_CreateModuleItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/modules/1/items").
+		AddMatcher(matchForm(url.Values{
+			"module_item[indent]":                       {"0"},
+			"module_item[new_tab]":                      {"false"},
+			"module_item[completion_requirement][type]": {"must_mark_done"},
+			"module_item[type]":                         {"Page"},
+			"module_item[page_url]":                     {"syllabus"},
+		})).
+		Reply(200).
+		JSON(ModuleItem{ID: 11, ModuleID: 1, Type: "Page", PageURL: "syllabus"})
+
+	got, err := client.CreateModuleItem(5, 1, &ModuleItem{
+		Type:                  "Page",
+		PageURL:               "syllabus",
+		CompletionRequirement: &CompletionRequirement{Type: "must_mark_done"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), got.ID)
+}
+
+func TestCanvasClient_UpdateModuleItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/modules/1/items/11").
+		AddMatcher(matchForm(url.Values{
+			"module_item[published]": {"false"},
+			"module_item[module_id]": {"2"},
+		})).
+		Reply(200).
+		JSON(ModuleItem{ID: 11, ModuleID: 2, Type: "Page"})
+
+	got, err := client.UpdateModuleItem(5, 1, &ModuleItem{ID: 11, ModuleID: 2}, "published", "module_id")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), got.ModuleID)
+
+	_, err = client.UpdateModuleItem(5, 1, &ModuleItem{ID: 11})
+	assert.EqualError(t, err, "no fields to update")
+
+	_, err = client.UpdateModuleItem(5, 1, &ModuleItem{ID: 11}, "publish", "module")
+	assert.EqualError(t, err, `unknown fields to update: "module", "publish"`)
+}
+
+func TestCanvasClient_MarkModuleItemDone(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/modules/1/items/11/done").
+		Reply(204)
+
+	err := client.MarkModuleItemDone(5, 1, 11, true)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
+
+func TestCanvasClient_GetModuleItemSequence(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/module_item_sequence").
+		MatchParam("asset_type", "Assignment").
+		MatchParam("asset_id", "40").
+		Reply(200).
+		BodyString(`{"items": [{"prev": {"id": 9, "title": "Reading"}, "current": {"id": 10}, "next": null}],
+			"modules": [{"id": 1, "name": "Week 1"}]}`)
+
+	got, err := client.GetModuleItemSequence(5, "Assignment", 40)
+	assert.Nil(t, err)
+	assert.Equal(t, "Reading", got.Items[0].Prev.Title)
+	assert.Nil(t, got.Items[0].Next)
+	assert.Equal(t, "Week 1", got.Modules[0].Name)
+}
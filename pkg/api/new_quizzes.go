@@ -0,0 +1,506 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Interaction types of a New Quizzes item
+const (
+	CategorizationInteraction = "categorization"
+	ChoiceInteraction         = "choice"
+	EssayInteraction          = "essay"
+	FileUploadInteraction     = "file-upload"
+	FillBlankInteraction      = "fill-blank"
+	FormulaInteraction        = "formula"
+	HotSpotInteraction        = "hot-spot"
+	MatchingInteraction       = "matching"
+	MultiAnswerInteraction    = "multi-answer"
+	NumericInteraction        = "numeric"
+	OrderingInteraction       = "ordering"
+	RichFillBlankInteraction  = "rich-fill-blank"
+	TrueFalseInteraction      = "true-false"
+)
+
+// Entry types of a New Quizzes item
+const (
+	EntryTypeItem      = "Item"
+	EntryTypeStimulus  = "Stimulus"
+	EntryTypeBankEntry = "BankEntry"
+	EntryTypeBank      = "Bank"
+)
+
+// NewQuizzesClient is a client for the New Quizzes api.
+// It shares the authorization and pagination of the CanvasClient it was created from
+type NewQuizzesClient struct {
+	canvas *CanvasClient
+}
+
+// NewQuizzes returns a client for the New Quizzes api
+func (c *CanvasClient) NewQuizzes() *NewQuizzesClient {
+	return &NewQuizzesClient{canvas: c}
+}
+
+// ClientURL returns the root URL of the New Quizzes api
+func (q *NewQuizzesClient) ClientURL() string {
+	return fmt.Sprintf("%s/api/quiz/v1", q.canvas.ClientURL())
+}
+
+// NewQuiz is a quiz of the New Quizzes engine. Its ID is the id of the assignment backing it
+type NewQuiz struct {
+	ID                json.Number      `json:"id"`
+	Title             string           `json:"title"`
+	Instructions      string           `json:"instructions"`
+	AssignmentGroupID int64            `json:"assignment_group_id"`
+	PointsPossible    float64          `json:"points_possible"`
+	DueAt             string           `json:"due_at"`
+	LockAt            string           `json:"lock_at"`
+	UnlockAt          string           `json:"unlock_at"`
+	Published         bool             `json:"published"`
+	GradingType       string           `json:"grading_type"`
+	QuizSettings      *NewQuizSettings `json:"quiz_settings,omitempty"`
+}
+
+// NewQuizSettings are the settings of a New Quiz
+type NewQuizSettings struct {
+	CalculatorType            string                   `json:"calculator_type,omitempty"`
+	FilterIPAddress           bool                     `json:"filter_ip_address"`
+	Filters                   json.RawMessage          `json:"filters,omitempty"`
+	OneAtATimeType            string                   `json:"one_at_a_time_type,omitempty"`
+	AllowBacktracking         bool                     `json:"allow_backtracking"`
+	ShuffleAnswers            bool                     `json:"shuffle_answers"`
+	ShuffleQuestions          bool                     `json:"shuffle_questions"`
+	RequireStudentAccessCode  bool                     `json:"require_student_access_code"`
+	StudentAccessCode         string                   `json:"student_access_code,omitempty"`
+	HasTimeLimit              bool                     `json:"has_time_limit"`
+	SessionTimeLimitInSeconds int64                    `json:"session_time_limit_in_seconds,omitempty"`
+	MultipleAttempts          *NewQuizMultipleAttempts `json:"multiple_attempts,omitempty"`
+	ResultViewSettings        *NewQuizResultView       `json:"result_view_settings,omitempty"`
+}
+
+// NewQuizMultipleAttempts are the attempt settings of a New Quiz
+type NewQuizMultipleAttempts struct {
+	MultipleAttemptsEnabled bool  `json:"multiple_attempts_enabled"`
+	AttemptLimit            bool  `json:"attempt_limit"`
+	MaxAttempts             int64 `json:"max_attempts,omitempty"`
+	// ScoreToKeep is "average", "first", "highest" or "latest"
+	ScoreToKeep          string `json:"score_to_keep,omitempty"`
+	CoolingPeriod        bool   `json:"cooling_period"`
+	CoolingPeriodSeconds int64  `json:"cooling_period_seconds,omitempty"`
+}
+
+// NewQuizResultView are the settings of what students see after submitting a New Quiz
+type NewQuizResultView struct {
+	ResultViewRestricted           bool   `json:"result_view_restricted"`
+	DisplayPointsAwarded           bool   `json:"display_points_awarded"`
+	DisplayPointsPossible          bool   `json:"display_points_possible"`
+	DisplayItems                   bool   `json:"display_items"`
+	DisplayItemResponse            bool   `json:"display_item_response"`
+	DisplayItemResponseCorrectness bool   `json:"display_item_response_correctness"`
+	DisplayItemCorrectAnswer       bool   `json:"display_item_correct_answer"`
+	DisplayItemFeedback            bool   `json:"display_item_feedback"`
+	DisplayCorrectAnswerAt         string `json:"display_correct_answer_at,omitempty"`
+	HideCorrectAnswerAt            string `json:"hide_correct_answer_at,omitempty"`
+	DisplayItemResponseQualifier   string `json:"display_item_response_qualifier,omitempty"`
+	ShowItemResponsesAt            string `json:"show_item_responses_at,omitempty"`
+	HideItemResponsesAt            string `json:"hide_item_responses_at,omitempty"`
+}
+
+// NewQuizItem is an entry of a New Quiz: a question, a stimulus or a pull from an item bank
+type NewQuizItem struct {
+	ID                  json.Number      `json:"id"`
+	Position            int64            `json:"position"`
+	PointsPossible      float64          `json:"points_possible"`
+	EntryType           string           `json:"entry_type"`
+	EntryEditable       bool             `json:"entry_editable"`
+	StimulusQuizEntryID json.Number      `json:"stimulus_quiz_entry_id"`
+	Status              string           `json:"status"`
+	Entry               NewQuizItemEntry `json:"entry"`
+}
+
+// NewQuizItemEntry is the content of a New Quiz item.
+// The shape of InteractionData, Properties and ScoringData depends on InteractionTypeSlug.
+// Typed data is provided for choice, multi-answer, true-false and essay items only,
+// the data of the other interaction types is kept as raw JSON to decode with DecodeInteraction
+type NewQuizItemEntry struct {
+	Title               string               `json:"title,omitempty"`
+	ItemBody            string               `json:"item_body,omitempty"`
+	CalculatorType      string               `json:"calculator_type,omitempty"`
+	InteractionTypeSlug string               `json:"interaction_type_slug,omitempty"`
+	InteractionData     json.RawMessage      `json:"interaction_data,omitempty"`
+	Properties          json.RawMessage      `json:"properties,omitempty"`
+	ScoringMethod       string               `json:"scoring_method,omitempty"`
+	ScoringData         json.RawMessage      `json:"scoring_data,omitempty"`
+	AnswerFeedback      map[string]string    `json:"answer_feedback,omitempty"`
+	Feedback            *NewQuizItemFeedback `json:"feedback,omitempty"`
+	// Body, Instructions, SourceURL and Orientation are set on stimulus entries
+	Body         string `json:"body,omitempty"`
+	Instructions string `json:"instructions,omitempty"`
+	SourceURL    string `json:"source_url,omitempty"`
+	Orientation  string `json:"orientation,omitempty"`
+}
+
+// NewQuizItemFeedback is the general feedback of a New Quiz item
+type NewQuizItemFeedback struct {
+	Neutral   string `json:"neutral,omitempty"`
+	Correct   string `json:"correct,omitempty"`
+	Incorrect string `json:"incorrect,omitempty"`
+}
+
+// SetInteraction encodes the interaction and scoring data of the entry
+func (e *NewQuizItemEntry) SetInteraction(interactionType string, interactionData interface{}, scoringData interface{}) error {
+	interaction, err := json.Marshal(interactionData)
+
+	if err != nil {
+		return err
+	}
+
+	scoring, err := json.Marshal(scoringData)
+
+	if err != nil {
+		return err
+	}
+
+	e.InteractionTypeSlug = interactionType
+	e.InteractionData = interaction
+	e.ScoringData = scoring
+
+	return nil
+}
+
+// DecodeInteraction decodes the interaction and scoring data of the entry into the given targets.
+// A nil target is skipped
+func (e *NewQuizItemEntry) DecodeInteraction(interactionData interface{}, scoringData interface{}) error {
+	if interactionData != nil && len(e.InteractionData) > 0 {
+		if err := json.Unmarshal(e.InteractionData, interactionData); err != nil {
+			return err
+		}
+	}
+
+	if scoringData != nil && len(e.ScoringData) > 0 {
+		if err := json.Unmarshal(e.ScoringData, scoringData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewQuizChoice is a choice of a choice, multi-answer or ordering item
+type NewQuizChoice struct {
+	ID       string `json:"id"`
+	Position int64  `json:"position"`
+	ItemBody string `json:"item_body"`
+}
+
+// NewQuizChoiceData is the interaction data of choice and multi-answer items
+type NewQuizChoiceData struct {
+	Choices []NewQuizChoice `json:"choices"`
+}
+
+// NewQuizChoiceScoring is the scoring data of a choice item, Value is the id of the correct choice
+type NewQuizChoiceScoring struct {
+	Value string `json:"value"`
+}
+
+// NewQuizMultiAnswerScoring is the scoring data of a multi-answer item, Value are the ids of the correct choices
+type NewQuizMultiAnswerScoring struct {
+	Value []string `json:"value"`
+}
+
+// NewQuizTrueFalseData is the interaction data of a true-false item
+type NewQuizTrueFalseData struct {
+	TrueChoice  string `json:"true_choice"`
+	FalseChoice string `json:"false_choice"`
+}
+
+// NewQuizTrueFalseScoring is the scoring data of a true-false item
+type NewQuizTrueFalseScoring struct {
+	Value bool `json:"value"`
+}
+
+// NewQuizEssayData is the interaction data of an essay item
+type NewQuizEssayData struct {
+	RCE              bool  `json:"rce"`
+	SpellCheck       bool  `json:"spell_check"`
+	WordCount        bool  `json:"word_count"`
+	WordLimitEnabled bool  `json:"word_limit_enabled"`
+	WordLimitMin     int64 `json:"word_limit_min,omitempty"`
+	WordLimitMax     int64 `json:"word_limit_max,omitempty"`
+	FileUpload       bool  `json:"file_upload"`
+}
+
+// NewQuizEssayScoring is the scoring data of an essay item, Value is a grading note
+type NewQuizEssayScoring struct {
+	Value string `json:"value"`
+}
+
+// NewQuizAccommodation is extra time or attempts given to a user.
+// ExtraTime is always sent, so an accommodation with no extra time removes it
+type NewQuizAccommodation struct {
+	UserID               int64 `json:"user_id"`
+	ExtraTime            int64 `json:"extra_time"`
+	ExtraAttempts        int64 `json:"extra_attempts,omitempty"`
+	ReduceChoicesEnabled bool  `json:"reduce_choices_enabled,omitempty"`
+	// ApplyToInProgressQuizSessions is only used by course accommodations
+	ApplyToInProgressQuizSessions bool `json:"apply_to_in_progress_quiz_sessions,omitempty"`
+}
+
+// NewQuizAccommodationResult is the outcome of setting accommodations
+type NewQuizAccommodationResult struct {
+	Message    string                        `json:"message"`
+	Successful []NewQuizAccommodation        `json:"successful"`
+	Failed     []NewQuizAccommodationFailure `json:"failed"`
+}
+
+// NewQuizAccommodationFailure is an accommodation that could not be set
+type NewQuizAccommodationFailure struct {
+	UserID int64  `json:"user_id"`
+	Error  string `json:"error"`
+}
+
+// GetQuizzes returns the New Quizzes of a course
+func (q *NewQuizzesClient) GetQuizzes(courseID int64) ([]NewQuiz, error) {
+	quizzes := make([]NewQuiz, 0)
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes", q.ClientURL(), courseID)
+	err := q.canvas.getPaginatedJSON(requestURL, &quizzes)
+
+	if err != nil {
+		return quizzes, err
+	}
+
+	return quizzes, nil
+}
+
+// GetQuiz returns the New Quiz backed by the assignment with the given assignmentID
+func (q *NewQuizzesClient) GetQuiz(courseID int64, assignmentID int64) (*NewQuiz, error) {
+	quiz := NewQuiz{}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d", q.ClientURL(), courseID, assignmentID)
+	err := q.canvas.getJSON(requestURL, &quiz)
+
+	if err != nil {
+		return &quiz, err
+	}
+
+	return &quiz, nil
+}
+
+// CreateQuiz creates a New Quiz in the course
+func (q *NewQuizzesClient) CreateQuiz(courseID int64, quiz *NewQuiz) (*NewQuiz, error) {
+	created := NewQuiz{}
+
+	payload, err := newQuizPayload(quiz, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes", q.ClientURL(), courseID)
+	err = q.canvas.sendJSONBody("POST", requestURL, payload, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateQuiz updates the named fields of the New Quiz, such as "title" or "due_at", to match the given one
+func (q *NewQuizzesClient) UpdateQuiz(courseID int64, quiz *NewQuiz, fields ...string) (*NewQuiz, error) {
+	updated := NewQuiz{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	payload, err := newQuizPayload(quiz, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%s", q.ClientURL(), courseID, quiz.ID)
+	err = q.canvas.sendJSONBody("PATCH", requestURL, payload, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteQuiz deletes the New Quiz backed by the assignment with the given assignmentID
+func (q *NewQuizzesClient) DeleteQuiz(courseID int64, assignmentID int64) error {
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d", q.ClientURL(), courseID, assignmentID)
+
+	return q.canvas.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func newQuizPayload(quiz *NewQuiz, fields formFields) (map[string]interface{}, error) {
+	payload := map[string]interface{}{}
+	if fields.has("title", true) {
+		payload["title"] = quiz.Title
+	}
+	if fields.has("instructions", true) {
+		payload["instructions"] = quiz.Instructions
+	}
+	if fields.has("published", true) {
+		payload["published"] = quiz.Published
+	}
+	if fields.has("assignment_group_id", quiz.AssignmentGroupID != 0) {
+		payload["assignment_group_id"] = quiz.AssignmentGroupID
+	}
+	if fields.has("points_possible", quiz.PointsPossible != 0) {
+		payload["points_possible"] = quiz.PointsPossible
+	}
+	if fields.has("due_at", quiz.DueAt != "") {
+		payload["due_at"] = quiz.DueAt
+	}
+	if fields.has("lock_at", quiz.LockAt != "") {
+		payload["lock_at"] = quiz.LockAt
+	}
+	if fields.has("unlock_at", quiz.UnlockAt != "") {
+		payload["unlock_at"] = quiz.UnlockAt
+	}
+	if fields.has("grading_type", quiz.GradingType != "") {
+		payload["grading_type"] = quiz.GradingType
+	}
+	if fields.has("quiz_settings", quiz.QuizSettings != nil) {
+		payload["quiz_settings"] = quiz.QuizSettings
+	}
+
+	return map[string]interface{}{"quiz": payload}, fields.unknown()
+}
+
+// GetItems returns the items of the New Quiz
+func (q *NewQuizzesClient) GetItems(courseID int64, assignmentID int64) ([]NewQuizItem, error) {
+	items := make([]NewQuizItem, 0)
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/items", q.ClientURL(), courseID, assignmentID)
+	err := q.canvas.getPaginatedJSON(requestURL, &items)
+
+	if err != nil {
+		return items, err
+	}
+
+	return items, nil
+}
+
+// GetItem returns the item of the New Quiz with the given itemID
+func (q *NewQuizzesClient) GetItem(courseID int64, assignmentID int64, itemID int64) (*NewQuizItem, error) {
+	item := NewQuizItem{}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/items/%d", q.ClientURL(), courseID, assignmentID, itemID)
+	err := q.canvas.getJSON(requestURL, &item)
+
+	if err != nil {
+		return &item, err
+	}
+
+	return &item, nil
+}
+
+// CreateItem adds the item to the New Quiz
+func (q *NewQuizzesClient) CreateItem(courseID int64, assignmentID int64, item *NewQuizItem) (*NewQuizItem, error) {
+	created := NewQuizItem{}
+
+	payload, err := newQuizItemPayload(item, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/items", q.ClientURL(), courseID, assignmentID)
+	err = q.canvas.sendJSONBody("POST", requestURL, payload, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateItem updates the named fields of the item of the New Quiz, such as "position" or "entry", to match the given one
+func (q *NewQuizzesClient) UpdateItem(courseID int64, assignmentID int64, item *NewQuizItem, fields ...string) (*NewQuizItem, error) {
+	updated := NewQuizItem{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	payload, err := newQuizItemPayload(item, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/items/%s", q.ClientURL(), courseID, assignmentID, item.ID)
+	err = q.canvas.sendJSONBody("PATCH", requestURL, payload, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteItem removes the item with the given itemID from the New Quiz
+func (q *NewQuizzesClient) DeleteItem(courseID int64, assignmentID int64, itemID int64) error {
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/items/%d", q.ClientURL(), courseID, assignmentID, itemID)
+
+	return q.canvas.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func newQuizItemPayload(item *NewQuizItem, fields formFields) (map[string]interface{}, error) {
+	payload := map[string]interface{}{}
+	if fields.has("entry_type", true) {
+		entryType := item.EntryType
+		if entryType == "" {
+			entryType = EntryTypeItem
+		}
+		payload["entry_type"] = entryType
+	}
+	if fields.has("entry", true) {
+		payload["entry"] = item.Entry
+	}
+	if fields.has("position", item.Position != 0) {
+		payload["position"] = item.Position
+	}
+	if fields.has("points_possible", item.PointsPossible != 0) {
+		payload["points_possible"] = item.PointsPossible
+	}
+
+	return map[string]interface{}{"item": payload}, fields.unknown()
+}
+
+// SetAccommodations sets accommodations of users for the New Quiz
+func (q *NewQuizzesClient) SetAccommodations(courseID int64, assignmentID int64, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	requestURL := fmt.Sprintf("%s/courses/%d/quizzes/%d/accommodations", q.ClientURL(), courseID, assignmentID)
+
+	return q.setAccommodations(requestURL, accommodations)
+}
+
+// SetCourseAccommodations sets accommodations of users for every New Quiz of the course
+func (q *NewQuizzesClient) SetCourseAccommodations(courseID int64, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	requestURL := fmt.Sprintf("%s/courses/%d/accommodations", q.ClientURL(), courseID)
+
+	return q.setAccommodations(requestURL, accommodations)
+}
+
+func (q *NewQuizzesClient) setAccommodations(requestURL string, accommodations []NewQuizAccommodation) (*NewQuizAccommodationResult, error) {
+	result := NewQuizAccommodationResult{}
+
+	err := q.canvas.sendJSONBody("POST", requestURL, accommodations, &result)
+
+	if err != nil {
+		return &result, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewQuizzesClient_GetQuizzes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/quiz/v1/courses/5/quizzes").
+		MatchParam("page", "2").
+		Reply(200).
+		BodyString(`[{"id": "13", "title": "Retake", "quiz_settings": {"has_time_limit": true, "session_time_limit_in_seconds": 600}}]`)
+
+	gock.New(domain).
+		Get("/api/quiz/v1/courses/5/quizzes").
+		Reply(200).
+		SetHeader("Link", `<https://domain.instructure.com/api/quiz/v1/courses/5/quizzes?page=2>; rel="next"`).
+		BodyString(`[{"id": "12", "title": "Final", "points_possible": 20}]`)
+
+	got, err := client.NewQuizzes().GetQuizzes(5)
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "12", got[0].ID.String())
+	assert.Equal(t, 20.0, got[0].PointsPossible)
+	assert.Equal(t, int64(600), got[1].QuizSettings.SessionTimeLimitInSeconds)
+	assert.True(t, gock.IsDone())
+}
+
+func TestNewQuizzesClient_CreateItem(t *testing.T) {
+	defer gock.Off()
+
+	entry := NewQuizItemEntry{Title: "Sum", ItemBody: "<p>2 + 2</p>", ScoringMethod: "exact_match"}
+	err := entry.SetInteraction(ChoiceInteraction,
+		NewQuizChoiceData{Choices: []NewQuizChoice{
+			{ID: "a", Position: 1, ItemBody: "3"},
+			{ID: "b", Position: 2, ItemBody: "4"},
+		}},
+		NewQuizChoiceScoring{Value: "b"},
+	)
+	assert.Nil(t, err)
+
+	gock.New(domain).
+		Post("/api/quiz/v1/courses/5/quizzes/12/items").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"item": map[string]interface{}{
+				"entry_type":      "Item",
+				"points_possible": 1,
+				"entry": map[string]interface{}{
+					"title":                 "Sum",
+					"item_body":             "<p>2 + 2</p>",
+					"interaction_type_slug": "choice",
+					"scoring_method":        "exact_match",
+					"interaction_data": map[string]interface{}{"choices": []map[string]interface{}{
+						{"id": "a", "position": 1, "item_body": "3"},
+						{"id": "b", "position": 2, "item_body": "4"},
+					}},
+					"scoring_data": map[string]interface{}{"value": "b"},
+				},
+			},
+		}).
+		Reply(200).
+		BodyString(`{"id": "40", "entry_type": "Item", "points_possible": 1, "entry": {
+			"interaction_type_slug": "choice",
+			"interaction_data": {"choices": [{"id": "a", "position": 1, "item_body": "3"}, {"id": "b", "position": 2, "item_body": "4"}]},
+			"scoring_data": {"value": "b"}
+		}}`)
+
+	got, err := client.NewQuizzes().CreateItem(5, 12, &NewQuizItem{PointsPossible: 1, Entry: entry})
+	assert.Nil(t, err)
+	assert.Equal(t, "40", got.ID.String())
+
+	data := NewQuizChoiceData{}
+	scoring := NewQuizChoiceScoring{}
+	assert.Nil(t, got.Entry.DecodeInteraction(&data, &scoring))
+	assert.Len(t, data.Choices, 2)
+	assert.Equal(t, "b", scoring.Value)
+}
+
+func TestNewQuizzesClient_UpdateQuiz(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Patch("/api/quiz/v1/courses/5/quizzes/12").
+		MatchType("json").
+		JSON(map[string]interface{}{"quiz": map[string]interface{}{"due_at": "2021-03-02T05:59:59Z"}}).
+		Reply(200).
+		BodyString(`{"id": "12", "title": "Final", "due_at": "2021-03-02T05:59:59Z", "published": true}`)
+
+	got, err := client.NewQuizzes().UpdateQuiz(5, &NewQuiz{ID: "12", DueAt: "2021-03-02T05:59:59Z"}, "due_at")
+	assert.Nil(t, err)
+	assert.Equal(t, "Final", got.Title)
+	assert.True(t, got.Published)
+
+	_, err = client.NewQuizzes().UpdateQuiz(5, &NewQuiz{ID: "12"}, "due")
+	assert.EqualError(t, err, `unknown fields to update: "due"`)
+}
+
+func TestNewQuizzesClient_UpdateItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Patch("/api/quiz/v1/courses/5/quizzes/12/items/40").
+		MatchType("json").
+		JSON(map[string]interface{}{"item": map[string]interface{}{"position": 2}}).
+		Reply(200).
+		BodyString(`{"id": "40", "entry_type": "Item", "position": 2, "entry": {"title": "Sum"}}`)
+
+	got, err := client.NewQuizzes().UpdateItem(5, 12, &NewQuizItem{ID: "40", Position: 2}, "position")
+	assert.Nil(t, err)
+	assert.Equal(t, "Sum", got.Entry.Title)
+}
+
+func TestNewQuizzesClient_SetAccommodations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/quiz/v1/courses/5/quizzes/12/accommodations").
+		JSON([]map[string]interface{}{{"user_id": 8, "extra_time": 15}, {"user_id": 9, "extra_time": 0}}).
+		Reply(200).
+		BodyString(`{"message": "Accommodations processed", "successful": [{"user_id": 8, "extra_time": 15}, {"user_id": 9, "extra_time": 0}], "failed": []}`)
+
+	got, err := client.NewQuizzes().SetAccommodations(5, 12, []NewQuizAccommodation{{UserID: 8, ExtraTime: 15}, {UserID: 9}})
+	assert.Nil(t, err)
+	assert.Equal(t, []NewQuizAccommodation{{UserID: 8, ExtraTime: 15}, {UserID: 9}}, got.Successful)
+	assert.Empty(t, got.Failed)
+}
@@ -0,0 +1,321 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is an access token issued by canvas
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresIn    int64     `json:"expires_in"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	User         *User     `json:"user"`
+}
+
+// Expired reports whether the token is past its expiration time.
+// Tokens without an expiration time never expire
+func (t *Token) Expired() bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+	return time.Now().After(t.ExpiresAt)
+}
+
+// TokenStore persists the tokens of an OAuth2 session between requests
+type TokenStore interface {
+	Load() (*Token, error)
+	Save(token *Token) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps the token in memory
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewMemoryTokenStore creates new store holding the token
+func NewMemoryTokenStore(token *Token) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+// Load returns the stored token
+func (s *MemoryTokenStore) Load() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, errors.New("token store is empty")
+	}
+	return s.token, nil
+}
+
+// Save replaces the stored token
+func (s *MemoryTokenStore) Save(token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+	return nil
+}
+
+// OAuth2Config is a developer key used for the OAuth2 web flow
+type OAuth2Config struct {
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+	// HTTPClient sends the token requests and the requests of clients created with NewOAuth2Client.
+	// When nil, http.DefaultClient is used
+	HTTPClient *http.Client
+}
+
+func (o *OAuth2Config) httpClient() *http.Client {
+	if o.HTTPClient == nil {
+		return http.DefaultClient
+	}
+
+	return o.HTTPClient
+}
+
+// AuthorizeOptions is an interface for building the authorize URL
+type AuthorizeOptions struct {
+	purpose    string
+	forceLogin bool
+	uniqueID   string
+	prompt     string
+}
+
+// AuthorizeOption is an adapter for generating options
+type AuthorizeOption func(*AuthorizeOptions)
+
+// WithPurpose names the session that the token is being created for
+func WithPurpose(purpose string) AuthorizeOption {
+	return func(ao *AuthorizeOptions) {
+		ao.purpose = purpose
+	}
+}
+
+// WithForceLogin forces the user to log in even if they have an active session
+func WithForceLogin() AuthorizeOption {
+	return func(ao *AuthorizeOptions) {
+		ao.forceLogin = true
+	}
+}
+
+// WithUniqueID prefills the login name on the login form
+func WithUniqueID(uniqueID string) AuthorizeOption {
+	return func(ao *AuthorizeOptions) {
+		ao.uniqueID = uniqueID
+	}
+}
+
+// WithPromptNone skips the login form and returns an error to the redirect URI
+// if the user is not already logged in
+func WithPromptNone() AuthorizeOption {
+	return func(ao *AuthorizeOptions) {
+		ao.prompt = "none"
+	}
+}
+
+// AuthorizeURL returns the URL the user is sent to in order to grant access
+func (o *OAuth2Config) AuthorizeURL(state string, setters ...AuthorizeOption) string {
+	args := &AuthorizeOptions{}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	q := url.Values{}
+	q.Add("client_id", o.ClientID)
+	q.Add("response_type", "code")
+	q.Add("redirect_uri", o.RedirectURI)
+	if state != "" {
+		q.Add("state", state)
+	}
+	if len(o.Scopes) != 0 {
+		q.Add("scope", strings.Join(o.Scopes, " "))
+	}
+	if args.purpose != "" {
+		q.Add("purpose", args.purpose)
+	}
+	if args.forceLogin {
+		q.Add("force_login", "1")
+	}
+	if args.uniqueID != "" {
+		q.Add("unique_id", args.uniqueID)
+	}
+	if args.prompt != "" {
+		q.Add("prompt", args.prompt)
+	}
+
+	return fmt.Sprintf("%s/login/oauth2/auth?%s", canvasURL(o.Domain), q.Encode())
+}
+
+// Exchange trades the code received on the redirect URI for a token
+func (o *OAuth2Config) Exchange(code string) (*Token, error) {
+	form := url.Values{}
+	form.Add("grant_type", "authorization_code")
+	form.Add("client_id", o.ClientID)
+	form.Add("client_secret", o.ClientSecret)
+	form.Add("redirect_uri", o.RedirectURI)
+	form.Add("code", code)
+
+	return requestToken(o.httpClient(), o.Domain, form)
+}
+
+// Refresh trades the refresh token for a new access token
+func (o *OAuth2Config) Refresh(refreshToken string) (*Token, error) {
+	form := url.Values{}
+	form.Add("grant_type", "refresh_token")
+	form.Add("client_id", o.ClientID)
+	form.Add("client_secret", o.ClientSecret)
+	form.Add("refresh_token", refreshToken)
+
+	token, err := requestToken(o.httpClient(), o.Domain, form)
+
+	if err != nil {
+		return token, err
+	}
+
+	// canvas does not issue a new refresh token on renewal
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// requestToken posts the grant to the token endpoint of the domain with the given client
+func requestToken(client *http.Client, domain string, form url.Values) (*Token, error) {
+	token := Token{}
+
+	requestURL := fmt.Sprintf("%s/login/oauth2/token", canvasURL(domain))
+	res, err := client.PostForm(requestURL, form)
+
+	if err != nil {
+		return &token, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return &token, fmt.Errorf("Status code is: %d", res.StatusCode)
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&token)
+
+	if err != nil {
+		return &token, err
+	}
+
+	if token.ExpiresIn != 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return &token, nil
+}
+
+// oauth2TokenSource reads tokens from the store and renews them when they expire
+type oauth2TokenSource struct {
+	mu     sync.Mutex
+	config *OAuth2Config
+	store  TokenStore
+}
+
+// TokenSource returns a source that keeps the token in the store fresh
+func (o *OAuth2Config) TokenSource(store TokenStore) RefreshableTokenSource {
+	return &oauth2TokenSource{
+		config: o,
+		store:  store,
+	}
+}
+
+// Token returns the stored token, renewing it first if it has expired
+func (s *oauth2TokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.store.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Expired() {
+		return token, nil
+	}
+
+	return s.refresh(token)
+}
+
+// Refresh renews the stored token
+func (s *oauth2TokenSource) Refresh() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.store.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.refresh(token)
+}
+
+func (s *oauth2TokenSource) refresh(token *Token) (*Token, error) {
+	if token.RefreshToken == "" {
+		return nil, errors.New("token has no refresh token")
+	}
+
+	renewed, err := s.config.Refresh(token.RefreshToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if renewed.User == nil {
+		renewed.User = token.User
+	}
+
+	err = s.store.Save(renewed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return renewed, nil
+}
+
+// NewOAuth2Client creates new client that authorizes requests with the token in the store
+// and renews it whenever canvas rejects it
+func NewOAuth2Client(config *OAuth2Config, store TokenStore) *CanvasClient {
+	c := NewClientWithTokenSource(config.Domain, config.TokenSource(store))
+	c.client = config.httpClient()
+
+	return c
+}
+
+// RevokeToken deletes the access token the client is using.
+// When expireSessions is set, the user is also logged out of canvas
+func (c *CanvasClient) RevokeToken(expireSessions bool) error {
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/login/oauth2/token", c.ClientURL()))
+
+	if err != nil {
+		return err
+	}
+
+	if expireSessions {
+		q := parsedURL.Query()
+		q.Add("expire_sessions", "1")
+		parsedURL.RawQuery = q.Encode()
+	}
+
+	return c.sendJSON("DELETE", parsedURL.String(), nil, nil)
+}
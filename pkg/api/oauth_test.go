@@ -0,0 +1,144 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestOAuth2Config_AuthorizeURL(t *testing.T) {
+	config := &OAuth2Config{
+		Domain:      "domain",
+		ClientID:    "10000000000001",
+		RedirectURI: "https://app.example.com/callback",
+		Scopes:      []string{"url:GET|/api/v1/users/self/todo", "url:GET|/api/v1/users/self/activity_stream"},
+	}
+
+	got, err := url.Parse(config.AuthorizeURL("xyz", WithForceLogin()))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "domain.instructure.com", got.Host)
+	assert.Equal(t, "/login/oauth2/auth", got.Path)
+
+	q := got.Query()
+	assert.Equal(t, "10000000000001", q.Get("client_id"))
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "https://app.example.com/callback", q.Get("redirect_uri"))
+	assert.Equal(t, "xyz", q.Get("state"))
+	assert.Equal(t, "url:GET|/api/v1/users/self/todo url:GET|/api/v1/users/self/activity_stream", q.Get("scope"))
+	assert.Equal(t, "1", q.Get("force_login"))
+}
+
+func TestOAuth2Config_Exchange(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/login/oauth2/token").
+		AddMatcher(matchForm(url.Values{
+			"grant_type":    {"authorization_code"},
+			"client_id":     {"id"},
+			"client_secret": {"secret"},
+			"redirect_uri":  {""},
+			"code":          {"abc"},
+		})).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"access_token":  "access",
+			"token_type":    "Bearer",
+			"refresh_token": "refresh",
+			"expires_in":    3600,
+			"user":          map[string]interface{}{"id": 42, "name": "Name"},
+		})
+
+	config := &OAuth2Config{Domain: "domain", ClientID: "id", ClientSecret: "secret"}
+	got, err := config.Exchange("abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken)
+	assert.Equal(t, int64(42), got.User.ID)
+	assert.False(t, got.Expired())
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestOAuth2Config_ExchangeWithHTTPClient(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/login/oauth2/token").
+		MatchHeader("User-Agent", "canvas-sync").
+		Reply(200).
+		JSON(map[string]interface{}{"access_token": "access"})
+
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req.Header.Set("User-Agent", "canvas-sync")
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	config := &OAuth2Config{Domain: "domain", HTTPClient: httpClient}
+	got, err := config.Exchange("abc")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "access", got.AccessToken)
+	assert.True(t, gock.IsDone())
+}
+
+func TestNewOAuth2Client_RefreshOnUnauthorized(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/todo").
+		MatchHeader("Authorization", "Bearer stale").
+		Reply(401)
+
+	gock.New(domain).
+		Post("/login/oauth2/token").
+		AddMatcher(matchForm(url.Values{
+			"grant_type":    {"refresh_token"},
+			"client_id":     {""},
+			"client_secret": {""},
+			"refresh_token": {"refresh"},
+		})).
+		Reply(200).
+		JSON(map[string]interface{}{"access_token": "fresh", "expires_in": 3600})
+
+	gock.New(domain).
+		Get("/api/v1/users/self/todo").
+		MatchHeader("Authorization", "Bearer fresh").
+		Reply(200).
+		JSON([]interface{}{})
+
+	store := NewMemoryTokenStore(&Token{AccessToken: "stale", RefreshToken: "refresh"})
+	c := NewOAuth2Client(&OAuth2Config{Domain: "domain"}, store)
+
+	_, err := c.GetTodo()
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+
+	stored, _ := store.Load()
+	assert.Equal(t, "fresh", stored.AccessToken)
+	assert.Equal(t, "refresh", stored.RefreshToken)
+}
+
+func TestCanvasClient_RevokeToken(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/login/oauth2/token").
+		MatchParam("expire_sessions", "1").
+		MatchHeader("Authorization", "Bearer thisIsAToken").
+		Reply(200)
+
+	err := client.RevokeToken(true)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OutcomeImport is an import of outcomes from a CSV file
+type OutcomeImport struct {
+	ID        int64  `json:"id"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	EndedAt   string `json:"ended_at"`
+	// WorkflowState is one of "created", "importing", "succeeded" or "failed"
+	WorkflowState string `json:"workflow_state"`
+	// Progress is the completed percentage of the import
+	Progress json.Number `json:"progress"`
+	Data     struct {
+		ImportType string `json:"import_type"`
+	} `json:"data"`
+	User             *User                `json:"user"`
+	ProcessingErrors []OutcomeImportError `json:"processing_errors"`
+}
+
+// OutcomeImportError is a row of the CSV of an outcome import that could not be imported
+type OutcomeImportError struct {
+	Row     int64
+	Message string
+}
+
+// UnmarshalJSON reads the error, which canvas sends as a pair of the row and the message
+func (e *OutcomeImportError) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &[]interface{}{&e.Row, &e.Message})
+}
+
+// Done reports whether the import has finished, successfully or not
+func (i *OutcomeImport) Done() bool {
+	return i.WorkflowState == "succeeded" || i.WorkflowState == "failed"
+}
+
+// ImportOutcomes imports the outcomes of the CSV into the context, such as "course_123" or "account_1", in the background.
+// The outcomes are put in the outcome group with the given groupID, or in the root outcome group when it is 0
+func (c *CanvasClient) ImportOutcomes(contextCode string, groupID int64, csv *FileUpload) (*OutcomeImport, error) {
+	i := OutcomeImport{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &i, err
+	}
+
+	requestURL := contextURL + "/outcome_imports"
+	if groupID != 0 {
+		requestURL = fmt.Sprintf("%s/group/%d", requestURL, groupID)
+	}
+
+	form := url.Values{}
+	form.Add("import_type", "instructure_csv")
+
+	err = c.sendMultipart("POST", requestURL, form, "attachment", csv, &i)
+
+	if err != nil {
+		return &i, err
+	}
+
+	return &i, nil
+}
+
+// GetOutcomeImport returns the current state of the outcome import of the context with the given importID
+func (c *CanvasClient) GetOutcomeImport(contextCode string, importID int64) (*OutcomeImport, error) {
+	return c.getOutcomeImport(contextCode, strconv.FormatInt(importID, 10))
+}
+
+// GetLatestOutcomeImport returns the current state of the latest outcome import of the context
+func (c *CanvasClient) GetLatestOutcomeImport(contextCode string) (*OutcomeImport, error) {
+	return c.getOutcomeImport(contextCode, "latest")
+}
+
+func (c *CanvasClient) getOutcomeImport(contextCode string, importID string) (*OutcomeImport, error) {
+	i := OutcomeImport{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &i, err
+	}
+
+	err = c.getJSON(fmt.Sprintf("%s/outcome_imports/%s", contextURL, importID), &i)
+
+	if err != nil {
+		return &i, err
+	}
+
+	return &i, nil
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_ImportOutcomes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/accounts/1/outcome_imports/group/2").
+		AddMatcher(matchMultipart(url.Values{"import_type": {"instructure_csv"}}, "attachment", "outcomes.csv", "vendor_guid,object_type,title\n")).
+		Reply(200).
+		BodyString(`{"id": 6, "workflow_state": "created", "progress": "0", "data": {"import_type": "instructure_csv"}}`)
+
+	got, err := client.ImportOutcomes("account_1", 2, &FileUpload{Name: "outcomes.csv", Content: strings.NewReader("vendor_guid,object_type,title\n")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), got.ID)
+	assert.False(t, got.Done())
+}
+
+func TestCanvasClient_GetLatestOutcomeImport(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/accounts/1/outcome_imports/latest").
+		Reply(200).
+		BodyString(`{"id": 6, "workflow_state": "succeeded", "progress": 100,
+			"processing_errors": [[2, "Missing required fields: title"]]}`)
+
+	got, err := client.GetLatestOutcomeImport("account_1")
+	assert.Nil(t, err)
+	assert.True(t, got.Done())
+	assert.Equal(t, "100", got.Progress.String())
+	assert.Equal(t, OutcomeImportError{Row: 2, Message: "Missing required fields: title"}, got.ProcessingErrors[0])
+}
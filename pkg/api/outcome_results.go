@@ -0,0 +1,227 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OutcomeResult is the score of a student on an outcome from a single assessment
+type OutcomeResult struct {
+	ID                    int64   `json:"id"`
+	Score                 float64 `json:"score"`
+	Percent               float64 `json:"percent"`
+	Mastery               bool    `json:"mastery"`
+	Hidden                bool    `json:"hidden"`
+	SubmittedOrAssessedAt string  `json:"submitted_or_assessed_at"`
+	Links                 struct {
+		UserID    json.Number `json:"user"`
+		OutcomeID json.Number `json:"learning_outcome"`
+		// AlignmentID is the asset code of the assessed assignment, such as "assignment_40"
+		AlignmentID string `json:"alignment"`
+	} `json:"links"`
+}
+
+// OutcomeRollup is the aggregated score of a student, or of the course, on every outcome
+type OutcomeRollup struct {
+	Scores []OutcomeRollupScore `json:"scores"`
+	Links  struct {
+		// UserID is empty for rollups aggregated over the course
+		UserID    json.Number `json:"user"`
+		SectionID json.Number `json:"section"`
+		CourseID  json.Number `json:"course"`
+		// Status is the enrollment state of the user
+		Status string `json:"status"`
+	} `json:"links"`
+}
+
+// OutcomeRollupScore is the aggregated score on a single outcome
+type OutcomeRollupScore struct {
+	Score float64 `json:"score"`
+	// Count is the number of results the score is calculated from
+	Count       int64  `json:"count"`
+	Title       string `json:"title"`
+	SubmittedAt string `json:"submitted_at"`
+	HidePoints  bool   `json:"hide_points"`
+	Links       struct {
+		OutcomeID json.Number `json:"outcome"`
+	} `json:"links"`
+}
+
+// OutcomeResults are the outcome results of a course along with the outcomes and users they refer to
+type OutcomeResults struct {
+	Results  []OutcomeResult
+	Outcomes []Outcome
+	Users    []User
+}
+
+// OutcomeRollups are the outcome rollups of a course along with the outcomes and users they refer to
+type OutcomeRollups struct {
+	Rollups  []OutcomeRollup
+	Outcomes []Outcome
+	Users    []User
+}
+
+// outcomeLinked are the objects canvas links to outcome results and rollups
+type outcomeLinked struct {
+	Outcomes []Outcome `json:"outcomes"`
+	Users    []User    `json:"users"`
+}
+
+// outcomeResultsResponse is the envelope canvas wraps outcome results in
+type outcomeResultsResponse struct {
+	OutcomeResults []OutcomeResult `json:"outcome_results"`
+	Linked         outcomeLinked   `json:"linked"`
+}
+
+// outcomeRollupsResponse is the envelope canvas wraps outcome rollups in
+type outcomeRollupsResponse struct {
+	Rollups []OutcomeRollup `json:"rollups"`
+	Linked  outcomeLinked   `json:"linked"`
+}
+
+// OutcomeResultsOptions is an interface for the lookup of outcome results and rollups
+type OutcomeResultsOptions struct {
+	userIDs       []int64
+	outcomeIDs    []int64
+	linkOutcomes  bool
+	linkUsers     bool
+	aggregateStat string
+	err           []error
+}
+
+// OutcomeResultsOption is an adapter for generating options
+type OutcomeResultsOption func(*OutcomeResultsOptions)
+
+// WithOutcomeUsers limits the results to those of the users with the given userIDs
+func WithOutcomeUsers(userIDs ...int64) OutcomeResultsOption {
+	return func(o *OutcomeResultsOptions) {
+		o.userIDs = append(o.userIDs, userIDs...)
+	}
+}
+
+// WithOutcomeIDs limits the results to those of the outcomes with the given outcomeIDs
+func WithOutcomeIDs(outcomeIDs ...int64) OutcomeResultsOption {
+	return func(o *OutcomeResultsOptions) {
+		o.outcomeIDs = append(o.outcomeIDs, outcomeIDs...)
+	}
+}
+
+// WithLinkedOutcomes includes the outcomes the results refer to
+func WithLinkedOutcomes() OutcomeResultsOption {
+	return func(o *OutcomeResultsOptions) {
+		o.linkOutcomes = true
+	}
+}
+
+// WithLinkedUsers includes the users the results refer to
+func WithLinkedUsers() OutcomeResultsOption {
+	return func(o *OutcomeResultsOptions) {
+		o.linkUsers = true
+	}
+}
+
+// WithCourseAggregate aggregates the rollups of every user into a single rollup for the course.
+// Stat can be only one of: {"mean" | "median"}. It only applies to rollups
+func WithCourseAggregate(stat string) OutcomeResultsOption {
+	return func(o *OutcomeResultsOptions) {
+		if stat != "mean" && stat != "median" {
+			o.err = append(o.err, errors.New("keyword stat can be only one of: 'mean' | 'median'"))
+		}
+		o.aggregateStat = stat
+	}
+}
+
+// GetOutcomeResults returns the outcome results of the course
+func (c *CanvasClient) GetOutcomeResults(courseID int64, setters ...OutcomeResultsOption) (*OutcomeResults, error) {
+	pages := make([]outcomeResultsResponse, 0)
+	r := OutcomeResults{Results: make([]OutcomeResult, 0)}
+
+	requestURL, err := c.outcomeResultsURL(fmt.Sprintf("%s/api/v1/courses/%d/outcome_results", c.ClientURL(), courseID), setters)
+
+	if err != nil {
+		return &r, err
+	}
+
+	err = c.getPaginatedObjects(requestURL, &pages)
+
+	if err != nil {
+		return &r, err
+	}
+
+	for _, page := range pages {
+		r.Results = append(r.Results, page.OutcomeResults...)
+		r.Outcomes = append(r.Outcomes, page.Linked.Outcomes...)
+		r.Users = append(r.Users, page.Linked.Users...)
+	}
+
+	return &r, nil
+}
+
+// GetOutcomeRollups returns the outcome rollups of the students of the course
+func (c *CanvasClient) GetOutcomeRollups(courseID int64, setters ...OutcomeResultsOption) (*OutcomeRollups, error) {
+	pages := make([]outcomeRollupsResponse, 0)
+	r := OutcomeRollups{Rollups: make([]OutcomeRollup, 0)}
+
+	requestURL, err := c.outcomeResultsURL(fmt.Sprintf("%s/api/v1/courses/%d/outcome_rollups", c.ClientURL(), courseID), setters)
+
+	if err != nil {
+		return &r, err
+	}
+
+	err = c.getPaginatedObjects(requestURL, &pages)
+
+	if err != nil {
+		return &r, err
+	}
+
+	for _, page := range pages {
+		r.Rollups = append(r.Rollups, page.Rollups...)
+		r.Outcomes = append(r.Outcomes, page.Linked.Outcomes...)
+		r.Users = append(r.Users, page.Linked.Users...)
+	}
+
+	return &r, nil
+}
+
+func (c *CanvasClient) outcomeResultsURL(requestURL string, setters []OutcomeResultsOption) (string, error) {
+	args := &OutcomeResultsOptions{}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return "", args.err[0]
+	}
+
+	parsedURL, err := url.Parse(requestURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	q := parsedURL.Query()
+
+	for _, id := range args.userIDs {
+		q.Add("user_ids[]", strconv.FormatInt(id, 10))
+	}
+	for _, id := range args.outcomeIDs {
+		q.Add("outcome_ids[]", strconv.FormatInt(id, 10))
+	}
+	if args.linkOutcomes {
+		q.Add("include[]", "outcomes")
+	}
+	if args.linkUsers {
+		q.Add("include[]", "users")
+	}
+	if args.aggregateStat != "" {
+		q.Add("aggregate", "course")
+		q.Add("aggregate_stat", args.aggregateStat)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetOutcomeResults(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_results").
+		MatchParam("user_ids[]", "8").
+		MatchParam("include[]", "outcomes").
+		Reply(200).
+		BodyString(`{"outcome_results": [{"id": 3, "score": 3, "mastery": true,
+			"links": {"user": "8", "learning_outcome": "17", "alignment": "assignment_40"}}],
+			"linked": {"outcomes": [{"id": 17, "title": "Argues clearly"}]}}`)
+
+	got, err := client.GetOutcomeResults(5, WithOutcomeUsers(8), WithLinkedOutcomes())
+	assert.Nil(t, err)
+	assert.Equal(t, "17", got.Results[0].Links.OutcomeID.String())
+	assert.Equal(t, "Argues clearly", got.Outcomes[0].Title)
+}
+
+func TestCanvasClient_GetOutcomeRollups(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_rollups").
+		MatchParam("aggregate", "course").
+		MatchParam("aggregate_stat", "median").
+		Reply(200).
+		BodyString(`{"rollups": [{"scores": [{"score": 2.5, "count": 4, "links": {"outcome": "17"}}], "links": {"course": 5}}]}`)
+
+	got, err := client.GetOutcomeRollups(5, WithCourseAggregate("median"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, got.Rollups[0].Scores[0].Score)
+	assert.Equal(t, "5", got.Rollups[0].Links.CourseID.String())
+}
+
+func TestCanvasClient_GetOutcomeRollups_InvalidStat(t *testing.T) {
+	_, err := client.GetOutcomeRollups(5, WithCourseAggregate("mode"))
+	assert.EqualError(t, err, "keyword stat can be only one of: 'mean' | 'median'")
+}
@@ -0,0 +1,392 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Outcome is a learning outcome that submissions are assessed against
+type Outcome struct {
+	ID          int64  `json:"id"`
+	URL         string `json:"url"`
+	ContextID   int64  `json:"context_id"`
+	ContextType string `json:"context_type"`
+	Title       string `json:"title"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	VendorGUID  string `json:"vendor_guid"`
+	// MasteryPoints is the score a student needs to master the outcome
+	MasteryPoints  float64         `json:"mastery_points"`
+	PointsPossible float64         `json:"points_possible"`
+	Ratings        []OutcomeRating `json:"ratings"`
+	// CalculationMethod is one of "decaying_average", "n_mastery", "latest", "highest" or "average"
+	CalculationMethod string `json:"calculation_method"`
+	// CalculationInt is the weight of the latest score for "decaying_average"
+	// and the number of times the outcome has to be mastered for "n_mastery"
+	CalculationInt       int64 `json:"calculation_int"`
+	CanEdit              bool  `json:"can_edit"`
+	CanUnlink            bool  `json:"can_unlink"`
+	Assessed             bool  `json:"assessed"`
+	HasUpdateableRubrics bool  `json:"has_updateable_rubrics"`
+}
+
+// OutcomeRating is a level of mastery of an outcome
+type OutcomeRating struct {
+	Description string  `json:"description"`
+	Points      float64 `json:"points"`
+}
+
+// OutcomeGroup is a folder of outcomes and other outcome groups
+type OutcomeGroup struct {
+	ID          int64  `json:"id"`
+	URL         string `json:"url"`
+	ContextID   int64  `json:"context_id"`
+	ContextType string `json:"context_type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	VendorGUID  string `json:"vendor_guid"`
+	// ParentOutcomeGroup is nil for the root outcome group of a context
+	ParentOutcomeGroup *OutcomeGroup `json:"parent_outcome_group"`
+	SubgroupsURL       string        `json:"subgroups_url"`
+	OutcomesURL        string        `json:"outcomes_url"`
+	ImportURL          string        `json:"import_url"`
+	CanEdit            bool          `json:"can_edit"`
+}
+
+// OutcomeLink is the placement of an outcome in an outcome group
+type OutcomeLink struct {
+	URL          string        `json:"url"`
+	ContextID    int64         `json:"context_id"`
+	ContextType  string        `json:"context_type"`
+	OutcomeGroup *OutcomeGroup `json:"outcome_group"`
+	Outcome      *Outcome      `json:"outcome"`
+	Assessed     bool          `json:"assessed"`
+	CanUnlink    bool          `json:"can_unlink"`
+}
+
+// GetOutcome returns the outcome with the given outcomeID
+func (c *CanvasClient) GetOutcome(outcomeID int64) (*Outcome, error) {
+	o := Outcome{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/outcomes/%d", c.ClientURL(), outcomeID)
+	err := c.getJSON(requestURL, &o)
+
+	if err != nil {
+		return &o, err
+	}
+
+	return &o, nil
+}
+
+// UpdateOutcome updates the named fields of the outcome, such as "title" or "ratings", to match the given one
+func (c *CanvasClient) UpdateOutcome(outcome *Outcome, fields ...string) (*Outcome, error) {
+	o := Outcome{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &o, err
+	}
+
+	form, err := outcomeForm(outcome, f)
+
+	if err != nil {
+		return &o, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/outcomes/%d", c.ClientURL(), outcome.ID)
+	err = c.sendJSON("PUT", requestURL, form, &o)
+
+	if err != nil {
+		return &o, err
+	}
+
+	return &o, nil
+}
+
+func outcomeForm(outcome *Outcome, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("title", outcome.Title)
+	}
+	if fields.has("display_name", true) {
+		form.Add("display_name", outcome.DisplayName)
+	}
+	if fields.has("description", true) {
+		form.Add("description", outcome.Description)
+	}
+	if fields.has("vendor_guid", outcome.VendorGUID != "") {
+		form.Add("vendor_guid", outcome.VendorGUID)
+	}
+	if fields.has("mastery_points", outcome.MasteryPoints != 0) {
+		form.Add("mastery_points", strconv.FormatFloat(outcome.MasteryPoints, 'f', -1, 64))
+	}
+	if fields.has("ratings", true) {
+		for i, rating := range outcome.Ratings {
+			form.Add(fmt.Sprintf("ratings[%d][description]", i), rating.Description)
+			form.Add(fmt.Sprintf("ratings[%d][points]", i), strconv.FormatFloat(rating.Points, 'f', -1, 64))
+		}
+	}
+	if fields.has("calculation_method", outcome.CalculationMethod != "") {
+		form.Add("calculation_method", outcome.CalculationMethod)
+	}
+	if fields.has("calculation_int", outcome.CalculationInt != 0) {
+		form.Add("calculation_int", strconv.FormatInt(outcome.CalculationInt, 10))
+	}
+
+	return form, fields.unknown()
+}
+
+// GetRootOutcomeGroup returns the outcome group at the root of the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetRootOutcomeGroup(contextCode string) (*OutcomeGroup, error) {
+	g := OutcomeGroup{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &g, err
+	}
+
+	err = c.getJSON(contextURL+"/root_outcome_group", &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+// GetOutcomeGroups returns every outcome group of the context
+func (c *CanvasClient) GetOutcomeGroups(contextCode string) ([]OutcomeGroup, error) {
+	g := make([]OutcomeGroup, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return g, err
+	}
+
+	err = c.getPaginatedJSON(contextURL+"/outcome_groups", &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// GetOutcomeGroup returns the outcome group of the context with the given groupID
+func (c *CanvasClient) GetOutcomeGroup(contextCode string, groupID int64) (*OutcomeGroup, error) {
+	return c.sendOutcomeGroup("GET", contextCode, fmt.Sprintf("/outcome_groups/%d", groupID), nil)
+}
+
+// CreateOutcomeGroup creates a subgroup of the outcome group with the given parentID
+func (c *CanvasClient) CreateOutcomeGroup(contextCode string, parentID int64, group *OutcomeGroup) (*OutcomeGroup, error) {
+	form, err := outcomeGroupForm(group, nil)
+
+	if err != nil {
+		return &OutcomeGroup{}, err
+	}
+
+	return c.sendOutcomeGroup("POST", contextCode, fmt.Sprintf("/outcome_groups/%d/subgroups", parentID), form)
+}
+
+// UpdateOutcomeGroup updates the named fields of the outcome group, such as "title" or "description", to match the given one.
+// Updating "parent_outcome_group_id" moves the group into its ParentOutcomeGroup
+func (c *CanvasClient) UpdateOutcomeGroup(contextCode string, group *OutcomeGroup, fields ...string) (*OutcomeGroup, error) {
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &OutcomeGroup{}, err
+	}
+
+	form, err := outcomeGroupForm(group, f)
+
+	if err != nil {
+		return &OutcomeGroup{}, err
+	}
+
+	return c.sendOutcomeGroup("PUT", contextCode, fmt.Sprintf("/outcome_groups/%d", group.ID), form)
+}
+
+// DeleteOutcomeGroup deletes the outcome group with the given groupID, its subgroups and its outcome links
+func (c *CanvasClient) DeleteOutcomeGroup(contextCode string, groupID int64) (*OutcomeGroup, error) {
+	return c.sendOutcomeGroup("DELETE", contextCode, fmt.Sprintf("/outcome_groups/%d", groupID), nil)
+}
+
+func (c *CanvasClient) sendOutcomeGroup(method string, contextCode string, path string, form url.Values) (*OutcomeGroup, error) {
+	g := OutcomeGroup{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &g, err
+	}
+
+	err = c.sendJSON(method, contextURL+path, form, &g)
+
+	if err != nil {
+		return &g, err
+	}
+
+	return &g, nil
+}
+
+func outcomeGroupForm(group *OutcomeGroup, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("title", group.Title)
+	}
+	if fields.has("description", true) {
+		form.Add("description", group.Description)
+	}
+	if fields.has("vendor_guid", group.VendorGUID != "") {
+		form.Add("vendor_guid", group.VendorGUID)
+	}
+	if p := group.ParentOutcomeGroup; fields.has("parent_outcome_group_id", false) && p != nil {
+		form.Add("parent_outcome_group_id", strconv.FormatInt(p.ID, 10))
+	}
+
+	return form, fields.unknown()
+}
+
+// GetOutcomeSubgroups returns the outcome groups directly inside the outcome group
+func (c *CanvasClient) GetOutcomeSubgroups(contextCode string, groupID int64) ([]OutcomeGroup, error) {
+	g := make([]OutcomeGroup, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return g, err
+	}
+
+	err = c.getPaginatedJSON(fmt.Sprintf("%s/outcome_groups/%d/subgroups", contextURL, groupID), &g)
+
+	if err != nil {
+		return g, err
+	}
+
+	return g, nil
+}
+
+// WalkOutcomeGroups calls fn for the root outcome group of the context and every group below it, parents before
+// their subgroups. Path holds the titles of the groups above the group. Walking stops at the first error fn returns
+func (c *CanvasClient) WalkOutcomeGroups(contextCode string, fn func(group *OutcomeGroup, path []string) error) error {
+	root, err := c.GetRootOutcomeGroup(contextCode)
+
+	if err != nil {
+		return err
+	}
+
+	return c.walkOutcomeGroup(contextCode, root, []string{}, fn)
+}
+
+func (c *CanvasClient) walkOutcomeGroup(contextCode string, group *OutcomeGroup, path []string, fn func(*OutcomeGroup, []string) error) error {
+	if err := fn(group, path); err != nil {
+		return err
+	}
+
+	subgroups, err := c.GetOutcomeSubgroups(contextCode, group.ID)
+
+	if err != nil {
+		return err
+	}
+
+	subpath := append(path[:len(path):len(path)], group.Title)
+	for i := range subgroups {
+		if err := c.walkOutcomeGroup(contextCode, &subgroups[i], subpath, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetOutcomeLinks returns the outcome links of the outcome group, or of every group of the context when groupID is 0
+func (c *CanvasClient) GetOutcomeLinks(contextCode string, groupID int64) ([]OutcomeLink, error) {
+	l := make([]OutcomeLink, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return l, err
+	}
+
+	requestURL := contextURL + "/outcome_group_links"
+	if groupID != 0 {
+		requestURL = fmt.Sprintf("%s/outcome_groups/%d/outcomes", contextURL, groupID)
+	}
+
+	err = c.getPaginatedJSON(requestURL, &l)
+
+	if err != nil {
+		return l, err
+	}
+
+	return l, nil
+}
+
+// CreateOutcome creates an outcome and links it into the outcome group
+func (c *CanvasClient) CreateOutcome(contextCode string, groupID int64, outcome *Outcome) (*OutcomeLink, error) {
+	form, err := outcomeForm(outcome, nil)
+
+	if err != nil {
+		return &OutcomeLink{}, err
+	}
+
+	return c.sendOutcomeLink("POST", contextCode, fmt.Sprintf("/outcome_groups/%d/outcomes", groupID), form)
+}
+
+// LinkOutcome links the existing outcome with the given outcomeID into the outcome group
+func (c *CanvasClient) LinkOutcome(contextCode string, groupID int64, outcomeID int64) (*OutcomeLink, error) {
+	return c.sendOutcomeLink("PUT", contextCode, fmt.Sprintf("/outcome_groups/%d/outcomes/%d", groupID, outcomeID), nil)
+}
+
+// UnlinkOutcome removes the outcome with the given outcomeID from the outcome group.
+// The outcome is deleted when it is not linked anywhere else, which fails if it has been assessed
+func (c *CanvasClient) UnlinkOutcome(contextCode string, groupID int64, outcomeID int64) (*OutcomeLink, error) {
+	return c.sendOutcomeLink("DELETE", contextCode, fmt.Sprintf("/outcome_groups/%d/outcomes/%d", groupID, outcomeID), nil)
+}
+
+func (c *CanvasClient) sendOutcomeLink(method string, contextCode string, path string, form url.Values) (*OutcomeLink, error) {
+	l := OutcomeLink{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &l, err
+	}
+
+	err = c.sendJSON(method, contextURL+path, form, &l)
+
+	if err != nil {
+		return &l, err
+	}
+
+	return &l, nil
+}
+
+// ImportOutcomeGroup copies the outcome group with the given sourceID, with its subgroups and outcome links,
+// into the outcome group with the given groupID in the background
+func (c *CanvasClient) ImportOutcomeGroup(contextCode string, groupID int64, sourceID int64) (*Progress, error) {
+	p := Progress{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &p, err
+	}
+
+	form := url.Values{}
+	form.Add("source_outcome_group_id", strconv.FormatInt(sourceID, 10))
+	form.Add("async", "true")
+
+	err = c.sendJSON("POST", fmt.Sprintf("%s/outcome_groups/%d/import", contextURL, groupID), form, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateOutcome(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/accounts/1/outcome_groups/2/outcomes").
+		AddMatcher(matchForm(url.Values{
+			"title":                   {"Argues clearly"},
+			"display_name":            {""},
+			"description":             {""},
+			"mastery_points":          {"3"},
+			"ratings[0][description]": {"Exceeds"},
+			"ratings[0][points]":      {"4"},
+			"ratings[1][description]": {"Meets"},
+			"ratings[1][points]":      {"3"},
+			"calculation_method":      {"decaying_average"},
+			"calculation_int":         {"65"},
+		})).
+		Reply(200).
+		BodyString(`{"context_id": 1, "context_type": "Account", "outcome_group": {"id": 2, "title": "Writing"},
+			"outcome": {"id": 17, "title": "Argues clearly", "mastery_points": 3, "calculation_method": "decaying_average",
+			"calculation_int": 65, "ratings": [{"description": "Exceeds", "points": 4}, {"description": "Meets", "points": 3}]}}`)
+
+	got, err := client.CreateOutcome("account_1", 2, &Outcome{
+		Title:             "Argues clearly",
+		MasteryPoints:     3,
+		Ratings:           []OutcomeRating{{Description: "Exceeds", Points: 4}, {Description: "Meets", Points: 3}},
+		CalculationMethod: "decaying_average",
+		CalculationInt:    65,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(17), got.Outcome.ID)
+	assert.Equal(t, "Writing", got.OutcomeGroup.Title)
+}
+
+func TestCanvasClient_UpdateOutcome(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/outcomes/17").
+		AddMatcher(matchForm(url.Values{"mastery_points": {"4"}})).
+		Reply(200).
+		JSON(Outcome{ID: 17, Title: "Argues clearly", MasteryPoints: 4})
+
+	got, err := client.UpdateOutcome(&Outcome{ID: 17, MasteryPoints: 4}, "mastery_points")
+	assert.Nil(t, err)
+	assert.Equal(t, "Argues clearly", got.Title)
+
+	_, err = client.UpdateOutcome(&Outcome{ID: 17})
+	assert.EqualError(t, err, "no fields to update")
+
+	_, err = client.UpdateOutcome(&Outcome{ID: 17}, "mastery")
+	assert.EqualError(t, err, `unknown fields to update: "mastery"`)
+}
+
+func TestCanvasClient_UpdateOutcomeGroup(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/outcome_groups/4").
+		AddMatcher(matchForm(url.Values{"parent_outcome_group_id": {"2"}})).
+		Reply(200).
+		JSON(OutcomeGroup{ID: 4, Title: "Writing", ParentOutcomeGroup: &OutcomeGroup{ID: 2}})
+
+	got, err := client.UpdateOutcomeGroup("course_5", &OutcomeGroup{ID: 4, ParentOutcomeGroup: &OutcomeGroup{ID: 2}}, "parent_outcome_group_id")
+	assert.Nil(t, err)
+	assert.Equal(t, "Writing", got.Title)
+
+	_, err = client.UpdateOutcomeGroup("course_5", &OutcomeGroup{ID: 4}, "parent")
+	assert.EqualError(t, err, `unknown fields to update: "parent"`)
+}
+
+func TestCanvasClient_WalkOutcomeGroups(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/root_outcome_group").
+		Reply(200).
+		JSON(OutcomeGroup{ID: 1, Title: "History"})
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_groups/1/subgroups").
+		Reply(200).
+		JSON([]OutcomeGroup{{ID: 2, Title: "Writing"}, {ID: 3, Title: "Sources"}})
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_groups/2/subgroups").
+		Reply(200).
+		JSON([]OutcomeGroup{{ID: 4, Title: "Essays"}})
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_groups/4/subgroups").
+		Reply(200).
+		JSON([]OutcomeGroup{})
+	gock.New(domain).
+		Get("/api/v1/courses/5/outcome_groups/3/subgroups").
+		Reply(200).
+		JSON([]OutcomeGroup{})
+
+	visited := make([]string, 0)
+	err := client.WalkOutcomeGroups("course_5", func(group *OutcomeGroup, path []string) error {
+		visited = append(visited, strings.Join(append(path, group.Title), "/"))
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"History", "History/Writing", "History/Writing/Essays", "History/Sources"}, visited)
+}
+
+func TestCanvasClient_LinkOutcome(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/outcome_groups/2/outcomes/17").
+		Reply(200).
+		BodyString(`{"context_type": "Course", "outcome": {"id": 17}, "can_unlink": true}`)
+
+	got, err := client.LinkOutcome("course_5", 2, 17)
+	assert.Nil(t, err)
+	assert.True(t, got.CanUnlink)
+}
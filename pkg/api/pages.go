@@ -0,0 +1,330 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Page is a wiki page of a course or a group
+type Page struct {
+	PageID int64 `json:"page_id"`
+	// URL is the unique locator of the page, used in place of an id by the api
+	URL       string `json:"url"`
+	Title     string `json:"title"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	// EditingRoles is a comma separated list of "teachers", "students", "members" or "public"
+	EditingRoles     string       `json:"editing_roles"`
+	LastEditedBy     *UserDisplay `json:"last_edited_by"`
+	Body             string       `json:"body"`
+	Published        bool         `json:"published"`
+	PublishAt        string       `json:"publish_at"`
+	FrontPage        bool         `json:"front_page"`
+	HideFromStudents bool         `json:"hide_from_students"`
+	LockedForUser    bool         `json:"locked_for_user"`
+	LockExplanation  string       `json:"lock_explanation"`
+	TodoDate         string       `json:"todo_date"`
+	HTMLURL          string       `json:"html_url"`
+	// NotifyOfUpdate notifies participants of the context about the change, it is only sent by the client
+	NotifyOfUpdate bool `json:"-"`
+}
+
+// PageRevision is a saved version of a page
+type PageRevision struct {
+	RevisionID int64        `json:"revision_id"`
+	UpdatedAt  string       `json:"updated_at"`
+	Latest     bool         `json:"latest"`
+	EditedBy   *UserDisplay `json:"edited_by"`
+	// URL, Title and Body are left out of revision listings
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// PagesOptions is an interface for the lookup of pages
+type PagesOptions struct {
+	sort       string
+	order      string
+	searchTerm string
+	published  *bool
+	body       bool
+	err        []error
+}
+
+// PagesOption is an adapter for generating options
+type PagesOption func(*PagesOptions)
+
+// WithPageSort sorts the pages
+// Sort can only be one of: {"title" | "created_at" | "updated_at"}, order one of: {"asc" | "desc"}
+func WithPageSort(sort string, order string) PagesOption {
+	if sort != "title" && sort != "created_at" && sort != "updated_at" {
+		return func(po *PagesOptions) {
+			po.err = append(po.err, errors.New("keyword sort can be only one of: 'title' | 'created_at' | 'updated_at'"))
+		}
+	}
+	if order != "asc" && order != "desc" {
+		return func(po *PagesOptions) {
+			po.err = append(po.err, errors.New("keyword order can be only one of: 'asc' | 'desc'"))
+		}
+	}
+	return func(po *PagesOptions) {
+		po.sort = sort
+		po.order = order
+	}
+}
+
+// WithPageSearchTerm limits the pages to those whose title matches the search term
+func WithPageSearchTerm(searchTerm string) PagesOption {
+	return func(po *PagesOptions) {
+		po.searchTerm = searchTerm
+	}
+}
+
+// WithPublishedPages limits the pages to published or unpublished ones
+func WithPublishedPages(published bool) PagesOption {
+	return func(po *PagesOptions) {
+		po.published = &published
+	}
+}
+
+// WithPageBodies includes the bodies of the pages, which listings leave out by default
+func WithPageBodies() PagesOption {
+	return func(po *PagesOptions) {
+		po.body = true
+	}
+}
+
+// pagesURL returns the URL of the pages of the context followed by the formatted path
+func (c *CanvasClient) pagesURL(contextCode string, path string, args ...interface{}) (string, error) {
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return "", err
+	}
+
+	return contextURL + fmt.Sprintf(path, args...), nil
+}
+
+// GetPages returns the pages of the context, such as "course_123" or "group_456"
+func (c *CanvasClient) GetPages(contextCode string, setters ...PagesOption) ([]Page, error) {
+	args := &PagesOptions{}
+	p := make([]Page, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return p, args.err[0]
+	}
+
+	requestURL, err := c.pagesURL(contextCode, "/pages")
+
+	if err != nil {
+		return p, err
+	}
+
+	parsedURL, err := url.Parse(requestURL)
+
+	if err != nil {
+		return p, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.sort != "" {
+		q.Add("sort", args.sort)
+		q.Add("order", args.order)
+	}
+	if args.searchTerm != "" {
+		q.Add("search_term", args.searchTerm)
+	}
+	if args.published != nil {
+		q.Add("published", strconv.FormatBool(*args.published))
+	}
+	if args.body {
+		q.Add("include[]", "body")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &p)
+
+	if err != nil {
+		return p, err
+	}
+
+	return p, nil
+}
+
+// GetPage returns the page with the given url of the context
+func (c *CanvasClient) GetPage(contextCode string, pageURL string) (*Page, error) {
+	return c.getPage(contextCode, "/pages/%s", url.PathEscape(pageURL))
+}
+
+// GetFrontPage returns the front page of the context
+func (c *CanvasClient) GetFrontPage(contextCode string) (*Page, error) {
+	return c.getPage(contextCode, "/front_page")
+}
+
+func (c *CanvasClient) getPage(contextCode string, path string, args ...interface{}) (*Page, error) {
+	p := Page{}
+
+	requestURL, err := c.pagesURL(contextCode, path, args...)
+
+	if err != nil {
+		return &p, err
+	}
+
+	err = c.getJSON(requestURL, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
+
+// CreatePage creates a page in the context
+func (c *CanvasClient) CreatePage(contextCode string, page *Page) (*Page, error) {
+	form, err := pageForm(page, nil)
+
+	if err != nil {
+		return &Page{}, err
+	}
+
+	return c.sendPage("POST", contextCode, form, "/pages")
+}
+
+// UpdatePage updates the named fields of the page with the url of the given page, such as "title" or "body", to match it.
+// Naming "notify_of_update" notifies the participants of the context about the change
+func (c *CanvasClient) UpdatePage(contextCode string, page *Page, fields ...string) (*Page, error) {
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &Page{}, err
+	}
+
+	form, err := pageForm(page, f)
+
+	if err != nil {
+		return &Page{}, err
+	}
+
+	return c.sendPage("PUT", contextCode, form, "/pages/%s", url.PathEscape(page.URL))
+}
+
+// SetFrontPage makes the page with the given url the front page of the context
+func (c *CanvasClient) SetFrontPage(contextCode string, pageURL string) (*Page, error) {
+	form := url.Values{}
+	form.Add("wiki_page[front_page]", "true")
+
+	return c.sendPage("PUT", contextCode, form, "/pages/%s", url.PathEscape(pageURL))
+}
+
+// DeletePage deletes the page with the given url and returns it.
+// The front page cannot be deleted
+func (c *CanvasClient) DeletePage(contextCode string, pageURL string) (*Page, error) {
+	return c.sendPage("DELETE", contextCode, nil, "/pages/%s", url.PathEscape(pageURL))
+}
+
+func (c *CanvasClient) sendPage(method string, contextCode string, form url.Values, path string, args ...interface{}) (*Page, error) {
+	p := Page{}
+
+	requestURL, err := c.pagesURL(contextCode, path, args...)
+
+	if err != nil {
+		return &p, err
+	}
+
+	err = c.sendJSON(method, requestURL, form, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
+
+func pageForm(page *Page, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("wiki_page[title]", page.Title)
+	}
+	if fields.has("body", true) {
+		form.Add("wiki_page[body]", page.Body)
+	}
+	if fields.has("published", true) {
+		form.Add("wiki_page[published]", strconv.FormatBool(page.Published))
+	}
+	if fields.has("editing_roles", page.EditingRoles != "") {
+		form.Add("wiki_page[editing_roles]", page.EditingRoles)
+	}
+	if fields.has("publish_at", page.PublishAt != "") {
+		form.Add("wiki_page[publish_at]", page.PublishAt)
+	}
+	if fields.has("student_todo_at", page.TodoDate != "") {
+		form.Add("wiki_page[student_todo_at]", page.TodoDate)
+	}
+	if fields.has("front_page", page.FrontPage) {
+		form.Add("wiki_page[front_page]", strconv.FormatBool(page.FrontPage))
+	}
+	if fields.has("notify_of_update", page.NotifyOfUpdate) {
+		form.Add("wiki_page[notify_of_update]", strconv.FormatBool(page.NotifyOfUpdate))
+	}
+
+	return form, fields.unknown()
+}
+
+// GetPageRevisions returns the revisions of the page, without their bodies
+func (c *CanvasClient) GetPageRevisions(contextCode string, pageURL string) ([]PageRevision, error) {
+	r := make([]PageRevision, 0)
+
+	requestURL, err := c.pagesURL(contextCode, "/pages/%s/revisions", url.PathEscape(pageURL))
+
+	if err != nil {
+		return r, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &r)
+
+	if err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// GetPageRevision returns the revision of the page with the given revisionID with its body
+func (c *CanvasClient) GetPageRevision(contextCode string, pageURL string, revisionID int64) (*PageRevision, error) {
+	return c.sendPageRevision("GET", contextCode, "/pages/%s/revisions/%d", url.PathEscape(pageURL), revisionID)
+}
+
+// GetLatestPageRevision returns the current revision of the page with its body
+func (c *CanvasClient) GetLatestPageRevision(contextCode string, pageURL string) (*PageRevision, error) {
+	return c.sendPageRevision("GET", contextCode, "/pages/%s/revisions/latest", url.PathEscape(pageURL))
+}
+
+// RevertPage restores the page to the revision with the given revisionID and returns the new revision
+func (c *CanvasClient) RevertPage(contextCode string, pageURL string, revisionID int64) (*PageRevision, error) {
+	return c.sendPageRevision("POST", contextCode, "/pages/%s/revisions/%d", url.PathEscape(pageURL), revisionID)
+}
+
+func (c *CanvasClient) sendPageRevision(method string, contextCode string, path string, args ...interface{}) (*PageRevision, error) {
+	r := PageRevision{}
+
+	requestURL, err := c.pagesURL(contextCode, path, args...)
+
+	if err != nil {
+		return &r, err
+	}
+
+	err = c.sendJSON(method, requestURL, nil, &r)
+
+	if err != nil {
+		return &r, err
+	}
+
+	return &r, nil
+}
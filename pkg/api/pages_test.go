@@ -0,0 +1,106 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetPages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/groups/7/pages").
+		MatchParam("sort", "updated_at").
+		MatchParam("order", "desc").
+		MatchParam("include[]", "body").
+		Reply(200).
+		JSON([]Page{{PageID: 1, URL: "notes", Title: "Notes", Body: "<p>hi</p>"}})
+
+	got, err := client.GetPages("group_7", WithPageSort("updated_at", "desc"), WithPageBodies())
+	assert.Nil(t, err)
+	assert.Equal(t, []Page{{PageID: 1, URL: "notes", Title: "Notes", Body: "<p>hi</p>"}}, got)
+
+	_, err = client.GetPages("section_7")
+	assert.NotNil(t, err)
+}
+
+func TestCanvasClient_UpdatePage(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/pages/week-1").
+		AddMatcher(matchForm(url.Values{
+			"wiki_page[title]":            {"Week 1"},
+			"wiki_page[body]":             {"<h1>Week 1</h1>"},
+			"wiki_page[published]":        {"false"},
+			"wiki_page[notify_of_update]": {"true"},
+		})).
+		Reply(200).
+		JSON(Page{URL: "week-1", Title: "Week 1", Body: "<h1>Week 1</h1>"})
+
+	got, err := client.UpdatePage("course_5", &Page{URL: "week-1", Title: "Week 1", Body: "<h1>Week 1</h1>", NotifyOfUpdate: true},
+		"title", "body", "published", "notify_of_update")
+	assert.Nil(t, err)
+	assert.Equal(t, "<h1>Week 1</h1>", got.Body)
+
+	_, err = client.UpdatePage("course_5", &Page{URL: "week-1"})
+	assert.EqualError(t, err, "no fields to update")
+
+	_, err = client.UpdatePage("course_5", &Page{URL: "week-1"}, "todo_date")
+	assert.EqualError(t, err, `unknown fields to update: "todo_date"`)
+}
+
+func TestCanvasClient_UpdatePageBody(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/pages/week-1").
+		AddMatcher(matchForm(url.Values{"wiki_page[body]": {"<h1>Week one</h1>"}})).
+		Reply(200).
+		JSON(Page{URL: "week-1", Title: "Week 1", Body: "<h1>Week one</h1>", Published: true})
+
+	got, err := client.UpdatePage("course_5", &Page{URL: "week-1", Body: "<h1>Week one</h1>"}, "body")
+	assert.Nil(t, err)
+	assert.Equal(t, "Week 1", got.Title)
+	assert.True(t, got.Published)
+}
+
+func TestCanvasClient_GetFrontPage(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/front_page").
+		Reply(200).
+		JSON(Page{URL: "home", FrontPage: true, LastEditedBy: &UserDisplay{ID: 8, DisplayName: "Ada"}})
+
+	got, err := client.GetFrontPage("course_5")
+	assert.Nil(t, err)
+	assert.True(t, got.FrontPage)
+	assert.Equal(t, "Ada", got.LastEditedBy.DisplayName)
+}
+
+func TestCanvasClient_PageRevisions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/pages/home/revisions").
+		Reply(200).
+		JSON([]PageRevision{{RevisionID: 2, Latest: true}, {RevisionID: 1}})
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/pages/home/revisions/1").
+		Reply(200).
+		JSON(PageRevision{RevisionID: 3, Latest: true, Body: "<p>first</p>"})
+
+	revisions, err := client.GetPageRevisions("course_5", "home")
+	assert.Nil(t, err)
+	assert.Len(t, revisions, 2)
+
+	got, err := client.RevertPage("course_5", "home", revisions[1].RevisionID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), got.RevisionID)
+	assert.Equal(t, "<p>first</p>", got.Body)
+}
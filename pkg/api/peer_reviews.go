@@ -0,0 +1,128 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// PeerReview is the assignment of a reviewer to the submission of another student
+type PeerReview struct {
+	ID         int64  `json:"id"`
+	AssessorID int64  `json:"assessor_id"`
+	AssetID    int64  `json:"asset_id"`
+	AssetType  string `json:"asset_type"`
+	UserID     int64  `json:"user_id"`
+	// WorkflowState is "assigned" or "completed"
+	WorkflowState string `json:"workflow_state"`
+	// User, Assessor and SubmissionComments are only set when they are included
+	User               *UserDisplay        `json:"user"`
+	Assessor           *UserDisplay        `json:"assessor"`
+	SubmissionComments []SubmissionComment `json:"submission_comments"`
+}
+
+// SubmissionComment is a comment left on a submission
+type SubmissionComment struct {
+	ID           int64         `json:"id"`
+	AuthorID     int64         `json:"author_id"`
+	AuthorName   string        `json:"author_name"`
+	Author       *UserDisplay  `json:"author"`
+	Comment      string        `json:"comment"`
+	CreatedAt    string        `json:"created_at"`
+	EditedAt     string        `json:"edited_at"`
+	MediaComment *MediaComment `json:"media_comment"`
+	Attachments  []File        `json:"attachments"`
+}
+
+// PeerReviewsOptions is an interface for the lookup of peer reviews
+type PeerReviewsOptions struct {
+	users    bool
+	comments bool
+}
+
+// PeerReviewsOption is an adapter for generating options
+type PeerReviewsOption func(*PeerReviewsOptions)
+
+// WithPeerReviewUsers includes the reviewed user and the reviewer
+func WithPeerReviewUsers() PeerReviewsOption {
+	return func(po *PeerReviewsOptions) {
+		po.users = true
+	}
+}
+
+// WithPeerReviewComments includes the comments the reviewer left on the submission
+func WithPeerReviewComments() PeerReviewsOption {
+	return func(po *PeerReviewsOptions) {
+		po.comments = true
+	}
+}
+
+// GetPeerReviews returns the peer reviews of the assignment
+func (c *CanvasClient) GetPeerReviews(courseID int64, assignmentID int64, setters ...PeerReviewsOption) ([]PeerReview, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/peer_reviews", c.ClientURL(), courseID, assignmentID)
+
+	return c.getPeerReviews(requestURL, setters)
+}
+
+// GetSubmissionPeerReviews returns the peer reviews of the submission
+func (c *CanvasClient) GetSubmissionPeerReviews(courseID int64, assignmentID int64, submissionID int64, setters ...PeerReviewsOption) ([]PeerReview, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/submissions/%d/peer_reviews", c.ClientURL(), courseID, assignmentID, submissionID)
+
+	return c.getPeerReviews(requestURL, setters)
+}
+
+func (c *CanvasClient) getPeerReviews(requestURL string, setters []PeerReviewsOption) ([]PeerReview, error) {
+	args := &PeerReviewsOptions{}
+	r := make([]PeerReview, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(requestURL)
+
+	if err != nil {
+		return r, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.users {
+		q.Add("include[]", "user")
+	}
+	if args.comments {
+		q.Add("include[]", "submission_comments")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getPaginatedJSON(parsedURL.String(), &r)
+
+	if err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// CreatePeerReview assigns the user with the given reviewerID to review the submission
+func (c *CanvasClient) CreatePeerReview(courseID int64, assignmentID int64, submissionID int64, reviewerID int64) (*PeerReview, error) {
+	return c.sendPeerReview("POST", courseID, assignmentID, submissionID, reviewerID)
+}
+
+// DeletePeerReview removes the user with the given reviewerID from the reviewers of the submission
+func (c *CanvasClient) DeletePeerReview(courseID int64, assignmentID int64, submissionID int64, reviewerID int64) (*PeerReview, error) {
+	return c.sendPeerReview("DELETE", courseID, assignmentID, submissionID, reviewerID)
+}
+
+func (c *CanvasClient) sendPeerReview(method string, courseID int64, assignmentID int64, submissionID int64, reviewerID int64) (*PeerReview, error) {
+	r := PeerReview{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/submissions/%d/peer_reviews?user_id=%d",
+		c.ClientURL(), courseID, assignmentID, submissionID, reviewerID)
+	err := c.sendJSON(method, requestURL, nil, &r)
+
+	if err != nil {
+		return &r, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetPeerReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/assignments/40/peer_reviews").
+		MatchParam("include[]", "user").
+		Reply(200).
+		BodyString(`[{"id": 1, "assessor_id": 9, "asset_id": 70, "asset_type": "Submission", "user_id": 8,
+			"workflow_state": "completed", "user": {"id": 8, "display_name": "Ada"},
+			"assessor": {"id": 9, "display_name": "Grace"},
+			"submission_comments": [{"id": 3, "author_id": 9, "comment": "Nice work"}]}]`)
+
+	got, err := client.GetPeerReviews(5, 40, WithPeerReviewUsers(), WithPeerReviewComments())
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "Grace", got[0].Assessor.DisplayName)
+	assert.Equal(t, "Nice work", got[0].SubmissionComments[0].Comment)
+}
+
+func TestCanvasClient_CreatePeerReview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/assignments/40/submissions/70/peer_reviews").
+		MatchParam("user_id", "9").
+		Reply(200).
+		JSON(PeerReview{ID: 2, AssessorID: 9, AssetID: 70, UserID: 8, WorkflowState: "assigned"})
+
+	got, err := client.CreatePeerReview(5, 40, 70, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, "assigned", got.WorkflowState)
+}
+
+func TestCanvasClient_DeletePeerReview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/api/v1/courses/5/assignments/40/submissions/70/peer_reviews").
+		MatchParam("user_id", "9").
+		Reply(200).
+		JSON(PeerReview{ID: 2, AssessorID: 9})
+
+	got, err := client.DeletePeerReview(5, 40, 70, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), got.ID)
+}
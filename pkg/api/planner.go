@@ -0,0 +1,370 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PlannerItem is an assignment, quiz, discussion, page or note shown in the planner
+type PlannerItem struct {
+	ContextType     string           `json:"context_type"`
+	ContextName     string           `json:"context_name"`
+	CourseID        int64            `json:"course_id"`
+	GroupID         int64            `json:"group_id"`
+	UserID          int64            `json:"user_id"`
+	PlannableID     int64            `json:"plannable_id"`
+	PlannableType   string           `json:"plannable_type"`
+	PlannableDate   string           `json:"plannable_date"`
+	NewActivity     bool             `json:"new_activity"`
+	HTMLURL         string           `json:"html_url"`
+	PlannerOverride *PlannerOverride `json:"planner_override"`
+	// Submissions is false for items that cannot be submitted and an object with
+	// the submission statuses otherwise
+	Submissions interface{} `json:"submissions"`
+	// Plannable is the object the item was created for, its shape depends on PlannableType
+	Plannable json.RawMessage `json:"plannable"`
+}
+
+// PlannerNote is a planner item created by the user
+type PlannerNote struct {
+	ID                  int64  `json:"id"`
+	Title               string `json:"title"`
+	Description         string `json:"description"`
+	UserID              int64  `json:"user_id"`
+	WorkflowState       string `json:"workflow_state"`
+	CourseID            int64  `json:"course_id"`
+	TodoDate            string `json:"todo_date"`
+	LinkedObjectType    string `json:"linked_object_type"`
+	LinkedObjectID      int64  `json:"linked_object_id"`
+	LinkedObjectHTMLURL string `json:"linked_object_html_url"`
+	LinkedObjectURL     string `json:"linked_object_url"`
+}
+
+// PlannerOverride marks a planner item as complete or dismissed for the user
+type PlannerOverride struct {
+	ID             int64  `json:"id"`
+	PlannableType  string `json:"plannable_type"`
+	PlannableID    int64  `json:"plannable_id"`
+	UserID         int64  `json:"user_id"`
+	AssignmentID   int64  `json:"assignment_id"`
+	WorkflowState  string `json:"workflow_state"`
+	MarkedComplete bool   `json:"marked_complete"`
+	Dismissed      bool   `json:"dismissed"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	DeletedAt      string `json:"deleted_at"`
+}
+
+// PlannerOptions is an interface for the lookup of planner items and notes
+type PlannerOptions struct {
+	startDate    time.Time
+	endDate      time.Time
+	contextCodes []string
+	filter       string
+	err          []error
+}
+
+// PlannerOption is an adapter for generating options
+type PlannerOption func(*PlannerOptions)
+
+// WithPlannerDates limits the planner to items between the start and end dates
+func WithPlannerDates(startDate time.Time, endDate time.Time) PlannerOption {
+	return func(po *PlannerOptions) {
+		po.startDate = startDate
+		po.endDate = endDate
+	}
+}
+
+// WithPlannerContextCodes limits the planner to the contexts, such as "course_123" or "user_456"
+func WithPlannerContextCodes(contextCodes ...string) PlannerOption {
+	return func(po *PlannerOptions) {
+		po.contextCodes = append(po.contextCodes, contextCodes...)
+	}
+}
+
+// WithPlannerFilter is a planner items filter
+// Filter can only be one of: {"new_activity" | "incomplete_items" | "complete_items"}
+func WithPlannerFilter(filter string) PlannerOption {
+	if filter != "new_activity" && filter != "incomplete_items" && filter != "complete_items" {
+		return func(po *PlannerOptions) {
+			po.err = append(po.err, errors.New("keyword filter can be only one of: 'new_activity' | 'incomplete_items' | 'complete_items'"))
+		}
+	}
+	return func(po *PlannerOptions) {
+		po.filter = filter
+	}
+}
+
+// plannerURL builds the URL of a planner endpoint with the options applied.
+// Filterable tells whether the endpoint supports WithPlannerFilter
+func (c *CanvasClient) plannerURL(path string, setters []PlannerOption, filterable bool) (string, error) {
+	args := &PlannerOptions{}
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return "", args.err[0]
+	}
+
+	if args.filter != "" && !filterable {
+		return "", errors.New("keyword filter is not supported by planner notes")
+	}
+
+	parsedURL, err := url.Parse(c.ClientURL() + path)
+
+	if err != nil {
+		return "", err
+	}
+
+	q := parsedURL.Query()
+
+	if !args.startDate.IsZero() {
+		q.Add("start_date", args.startDate.Format(time.RFC3339))
+	}
+	if !args.endDate.IsZero() {
+		q.Add("end_date", args.endDate.Format(time.RFC3339))
+	}
+	for _, code := range args.contextCodes {
+		q.Add("context_codes[]", code)
+	}
+	if args.filter != "" {
+		q.Add("filter", args.filter)
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	return parsedURL.String(), nil
+}
+
+// GetPlannerItems returns the planner items of the user
+func (c *CanvasClient) GetPlannerItems(setters ...PlannerOption) ([]PlannerItem, error) {
+	items := make([]PlannerItem, 0)
+
+	requestURL, err := c.plannerURL("/api/v1/planner/items", setters, true)
+
+	if err != nil {
+		return items, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &items)
+
+	if err != nil {
+		return items, err
+	}
+
+	return items, nil
+}
+
+// GetUserPlannerItems returns the planner items of an observed user
+func (c *CanvasClient) GetUserPlannerItems(userID int64, setters ...PlannerOption) ([]PlannerItem, error) {
+	items := make([]PlannerItem, 0)
+
+	requestURL, err := c.plannerURL(fmt.Sprintf("/api/v1/users/%d/planner/items", userID), setters, true)
+
+	if err != nil {
+		return items, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &items)
+
+	if err != nil {
+		return items, err
+	}
+
+	return items, nil
+}
+
+// GetPlannerNotes returns the planner notes of the user.
+// Notes can be limited by dates and contexts, WithPlannerFilter is rejected
+func (c *CanvasClient) GetPlannerNotes(setters ...PlannerOption) ([]PlannerNote, error) {
+	notes := make([]PlannerNote, 0)
+
+	requestURL, err := c.plannerURL("/api/v1/planner_notes", setters, false)
+
+	if err != nil {
+		return notes, err
+	}
+
+	err = c.getPaginatedJSON(requestURL, &notes)
+
+	if err != nil {
+		return notes, err
+	}
+
+	return notes, nil
+}
+
+// GetPlannerNote returns the planner note with the given noteID
+func (c *CanvasClient) GetPlannerNote(noteID int64) (*PlannerNote, error) {
+	note := PlannerNote{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner_notes/%d", c.ClientURL(), noteID)
+	err := c.getJSON(requestURL, &note)
+
+	if err != nil {
+		return &note, err
+	}
+
+	return &note, nil
+}
+
+// CreatePlannerNote creates a planner note from the title, description, todo date,
+// course and linked object of the note
+func (c *CanvasClient) CreatePlannerNote(note *PlannerNote) (*PlannerNote, error) {
+	created := PlannerNote{}
+
+	form, err := plannerNoteForm(note, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner_notes", c.ClientURL())
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdatePlannerNote updates the named fields of the note to match the given one.
+// Fields can be "title", "details", "todo_date" and "course_id", an empty TodoDate or CourseID clears it
+func (c *CanvasClient) UpdatePlannerNote(note *PlannerNote, fields ...string) (*PlannerNote, error) {
+	updated := PlannerNote{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := plannerNoteForm(note, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner_notes/%d", c.ClientURL(), note.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeletePlannerNote deletes the planner note with the given noteID
+func (c *CanvasClient) DeletePlannerNote(noteID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/planner_notes/%d", c.ClientURL(), noteID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func plannerNoteForm(note *PlannerNote, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("title", note.Title)
+	}
+	if fields.has("details", true) {
+		form.Add("details", note.Description)
+	}
+	if fields.has("todo_date", note.TodoDate != "") {
+		form.Add("todo_date", note.TodoDate)
+	}
+	if fields.has("course_id", note.CourseID != 0) {
+		if note.CourseID == 0 {
+			// an empty value detaches the note from its course
+			form.Add("course_id", "")
+		} else {
+			form.Add("course_id", strconv.FormatInt(note.CourseID, 10))
+		}
+	}
+	// canvas links the object of a note on creation only
+	if fields == nil && note.LinkedObjectType != "" {
+		form.Add("linked_object_type", note.LinkedObjectType)
+		form.Add("linked_object_id", strconv.FormatInt(note.LinkedObjectID, 10))
+	}
+	return form, fields.unknown()
+}
+
+// GetPlannerOverrides returns the planner overrides of the user
+func (c *CanvasClient) GetPlannerOverrides() ([]PlannerOverride, error) {
+	overrides := make([]PlannerOverride, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner/overrides", c.ClientURL())
+	err := c.getPaginatedJSON(requestURL, &overrides)
+
+	if err != nil {
+		return overrides, err
+	}
+
+	return overrides, nil
+}
+
+// GetPlannerOverride returns the planner override with the given overrideID
+func (c *CanvasClient) GetPlannerOverride(overrideID int64) (*PlannerOverride, error) {
+	override := PlannerOverride{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner/overrides/%d", c.ClientURL(), overrideID)
+	err := c.getJSON(requestURL, &override)
+
+	if err != nil {
+		return &override, err
+	}
+
+	return &override, nil
+}
+
+// CreatePlannerOverride marks the plannable object as complete or dismissed.
+// PlannableType is one of: {"announcement" | "assignment" | "discussion_topic" | "quiz" | "wiki_page" | "planner_note" | "calendar_event"}
+func (c *CanvasClient) CreatePlannerOverride(plannableType string, plannableID int64, markedComplete bool, dismissed bool) (*PlannerOverride, error) {
+	override := PlannerOverride{}
+
+	form := url.Values{}
+	form.Add("plannable_type", plannableType)
+	form.Add("plannable_id", strconv.FormatInt(plannableID, 10))
+	form.Add("marked_complete", strconv.FormatBool(markedComplete))
+	form.Add("dismissed", strconv.FormatBool(dismissed))
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner/overrides", c.ClientURL())
+	err := c.sendJSON("POST", requestURL, form, &override)
+
+	if err != nil {
+		return &override, err
+	}
+
+	return &override, nil
+}
+
+// UpdatePlannerOverride changes whether the overridden item is complete or dismissed
+func (c *CanvasClient) UpdatePlannerOverride(overrideID int64, markedComplete bool, dismissed bool) (*PlannerOverride, error) {
+	override := PlannerOverride{}
+
+	form := url.Values{}
+	form.Add("marked_complete", strconv.FormatBool(markedComplete))
+	form.Add("dismissed", strconv.FormatBool(dismissed))
+
+	requestURL := fmt.Sprintf("%s/api/v1/planner/overrides/%d", c.ClientURL(), overrideID)
+	err := c.sendJSON("PUT", requestURL, form, &override)
+
+	if err != nil {
+		return &override, err
+	}
+
+	return &override, nil
+}
+
+// DeletePlannerOverride deletes the planner override with the given overrideID
+func (c *CanvasClient) DeletePlannerOverride(overrideID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/planner/overrides/%d", c.ClientURL(), overrideID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetPlannerItems(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/planner/items").
+		MatchParam("start_date", "2021-03-01T00:00:00Z").
+		MatchParam("end_date", "2021-03-08T00:00:00Z").
+		MatchParam("context_codes[]", "course_5").
+		MatchParam("filter", "incomplete_items").
+		Reply(200).
+		BodyString(`[{
+			"context_type": "Course", "course_id": 5, "plannable_id": 20,
+			"plannable_type": "assignment", "plannable_date": "2021-03-02T05:59:59Z",
+			"submissions": false, "plannable": {"id": 20, "title": "Essay"},
+			"planner_override": {"id": 3, "marked_complete": true}
+		}]`)
+
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	got, err := client.GetPlannerItems(
+		WithPlannerDates(start, start.AddDate(0, 0, 7)),
+		WithPlannerContextCodes("course_5"),
+		WithPlannerFilter("incomplete_items"),
+	)
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "assignment", got[0].PlannableType)
+	assert.True(t, got[0].PlannerOverride.MarkedComplete)
+	assert.JSONEq(t, `{"id": 20, "title": "Essay"}`, string(got[0].Plannable))
+
+	_, err = client.GetPlannerItems(WithPlannerFilter("everything"))
+	assert.Error(t, err)
+}
+
+func TestCanvasClient_CreatePlannerNote(t *testing.T) {
+	defer gock.Off()
+
+	expected := &PlannerNote{ID: 9, Title: "Study", Description: "Chapter 4", CourseID: 5}
+
+	gock.New(domain).
+		Post("/api/v1/planner_notes").
+		AddMatcher(matchForm(url.Values{
+			"title":     {"Study"},
+			"details":   {"Chapter 4"},
+			"course_id": {"5"},
+		})).
+		Reply(200).
+		JSON(expected)
+
+	got, err := client.CreatePlannerNote(&PlannerNote{Title: "Study", Description: "Chapter 4", CourseID: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, got)
+}
+
+func TestCanvasClient_UpdatePlannerNote(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/planner_notes/9").
+		AddMatcher(matchForm(url.Values{
+			"todo_date": {"2021-03-01T00:00:00Z"},
+			"course_id": {""},
+		})).
+		Reply(200).
+		JSON(&PlannerNote{ID: 9, Title: "Study", Description: "Chapter 4", TodoDate: "2021-03-01T00:00:00Z"})
+
+	got, err := client.UpdatePlannerNote(&PlannerNote{ID: 9, TodoDate: "2021-03-01T00:00:00Z"}, "todo_date", "course_id")
+	assert.Nil(t, err)
+	assert.Equal(t, "Chapter 4", got.Description)
+
+	_, err = client.UpdatePlannerNote(&PlannerNote{ID: 9}, "linked_object_type")
+	assert.EqualError(t, err, `unknown fields to update: "linked_object_type"`)
+}
+
+func TestCanvasClient_GetPlannerNotes_Filter(t *testing.T) {
+	_, err := client.GetPlannerNotes(WithPlannerFilter("incomplete_items"))
+	assert.EqualError(t, err, "keyword filter is not supported by planner notes")
+}
+
+func TestCanvasClient_UpdatePlannerOverride(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/planner/overrides/3").
+		BodyString("dismissed=true&marked_complete=false").
+		Reply(200).
+		JSON(&PlannerOverride{ID: 3, Dismissed: true})
+
+	got, err := client.UpdatePlannerOverride(3, false, true)
+	assert.Nil(t, err)
+	assert.True(t, got.Dismissed)
+}
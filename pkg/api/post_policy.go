@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	setAssignmentPostPolicyMutation = `mutation($input: SetAssignmentPostPolicyInput!) {
+  result: setAssignmentPostPolicy(input: $input) { errors { message } }
+}`
+	setCoursePostPolicyMutation = `mutation($input: SetCoursePostPolicyInput!) {
+  result: setCoursePostPolicy(input: $input) { errors { message } }
+}`
+	postAssignmentGradesMutation = `mutation($input: PostAssignmentGradesInput!) {
+  result: postAssignmentGrades(input: $input) { progress { _id state completion message } errors { message } }
+}`
+	hideAssignmentGradesMutation = `mutation($input: HideAssignmentGradesInput!) {
+  result: hideAssignmentGrades(input: $input) { progress { _id state completion message } errors { message } }
+}`
+)
+
+// postPolicyResponse is the data of the post policy mutations, which are only available through GraphQL
+type postPolicyResponse struct {
+	Result struct {
+		Progress *struct {
+			ID         string  `json:"_id"`
+			State      string  `json:"state"`
+			Completion float64 `json:"completion"`
+			Message    string  `json:"message"`
+		} `json:"progress"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"result"`
+}
+
+// SetAssignmentPostPolicy sets whether grades of the assignment stay hidden from students until they are posted
+func (c *CanvasClient) SetAssignmentPostPolicy(assignmentID int64, postManually bool) error {
+	_, err := c.sendPostPolicy(setAssignmentPostPolicyMutation, map[string]interface{}{
+		"assignmentId": strconv.FormatInt(assignmentID, 10),
+		"postManually": postManually,
+	})
+
+	return err
+}
+
+// SetCoursePostPolicy sets whether grades of the assignments of the course stay hidden from students until they are posted
+func (c *CanvasClient) SetCoursePostPolicy(courseID int64, postManually bool) error {
+	_, err := c.sendPostPolicy(setCoursePostPolicyMutation, map[string]interface{}{
+		"courseId":     strconv.FormatInt(courseID, 10),
+		"postManually": postManually,
+	})
+
+	return err
+}
+
+// PostAssignmentGrades makes the grades of the assignment visible to students in the background.
+// When gradedOnly is set, only graded submissions are posted. No sectionIDs posts the grades of every section
+func (c *CanvasClient) PostAssignmentGrades(assignmentID int64, gradedOnly bool, sectionIDs ...int64) (*Progress, error) {
+	input := assignmentGradesInput(assignmentID, sectionIDs)
+	input["gradedOnly"] = gradedOnly
+
+	return c.sendPostPolicy(postAssignmentGradesMutation, input)
+}
+
+// HideAssignmentGrades hides the grades of the assignment from students in the background.
+// No sectionIDs hides the grades of every section
+func (c *CanvasClient) HideAssignmentGrades(assignmentID int64, sectionIDs ...int64) (*Progress, error) {
+	return c.sendPostPolicy(hideAssignmentGradesMutation, assignmentGradesInput(assignmentID, sectionIDs))
+}
+
+func assignmentGradesInput(assignmentID int64, sectionIDs []int64) map[string]interface{} {
+	input := map[string]interface{}{"assignmentId": strconv.FormatInt(assignmentID, 10)}
+	if len(sectionIDs) > 0 {
+		ids := make([]string, len(sectionIDs))
+		for i, id := range sectionIDs {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+		input["sectionIds"] = ids
+	}
+
+	return input
+}
+
+// sendPostPolicy runs the mutation and returns the Progress of the job it started, if any
+func (c *CanvasClient) sendPostPolicy(mutation string, input map[string]interface{}) (*Progress, error) {
+	p := Progress{}
+	r := postPolicyResponse{}
+
+	err := c.graphQL(mutation, map[string]interface{}{"input": input}, &r)
+
+	if err != nil {
+		return &p, err
+	}
+
+	if len(r.Result.Errors) > 0 {
+		return &p, fmt.Errorf("graphql: %s", r.Result.Errors[0].Message)
+	}
+
+	if r.Result.Progress == nil {
+		return &p, nil
+	}
+
+	p.ID, err = strconv.ParseInt(r.Result.Progress.ID, 10, 64)
+
+	if err != nil {
+		return &p, err
+	}
+
+	p.WorkflowState = r.Result.Progress.State
+	p.Completion = r.Result.Progress.Completion
+	p.Message = r.Result.Progress.Message
+
+	return &p, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Progress is the state of an asynchronous job started by canvas
+type Progress struct {
+	ID          int64   `json:"id"`
+	ContextID   int64   `json:"context_id"`
+	ContextType string  `json:"context_type"`
+	UserID      int64   `json:"user_id"`
+	Tag         string  `json:"tag"`
+	Completion  float64 `json:"completion"`
+	// WorkflowState is one of "queued", "running", "completed" or "failed"
+	WorkflowState string          `json:"workflow_state"`
+	CreatedAt     string          `json:"created_at"`
+	UpdatedAt     string          `json:"updated_at"`
+	Message       string          `json:"message"`
+	Results       json.RawMessage `json:"results"`
+	URL           string          `json:"url"`
+}
+
+// Done reports whether the job has finished, successfully or not
+func (p *Progress) Done() bool {
+	return p.WorkflowState == "completed" || p.WorkflowState == "failed"
+}
+
+// GetProgress returns the current state of the job with the given progressID
+func (c *CanvasClient) GetProgress(progressID int64) (*Progress, error) {
+	p := Progress{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/progress/%d", c.ClientURL(), progressID)
+	err := c.getJSON(requestURL, &p)
+
+	if err != nil {
+		return &p, err
+	}
+
+	return &p, nil
+}
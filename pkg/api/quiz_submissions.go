@@ -0,0 +1,309 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// QuizSubmission is an attempt of a student at a classic quiz
+type QuizSubmission struct {
+	ID                        int64   `json:"id"`
+	QuizID                    int64   `json:"quiz_id"`
+	UserID                    int64   `json:"user_id"`
+	SubmissionID              int64   `json:"submission_id"`
+	StartedAt                 string  `json:"started_at"`
+	FinishedAt                string  `json:"finished_at"`
+	EndAt                     string  `json:"end_at"`
+	Attempt                   int64   `json:"attempt"`
+	ExtraAttempts             int64   `json:"extra_attempts"`
+	ExtraTime                 int64   `json:"extra_time"`
+	ManuallyUnlocked          bool    `json:"manually_unlocked"`
+	TimeSpent                 int64   `json:"time_spent"`
+	Score                     float64 `json:"score"`
+	ScoreBeforeRegrade        float64 `json:"score_before_regrade"`
+	KeptScore                 float64 `json:"kept_score"`
+	FudgePoints               float64 `json:"fudge_points"`
+	HasSeenResults            bool    `json:"has_seen_results"`
+	WorkflowState             string  `json:"workflow_state"`
+	OverdueAndNeedsSubmission bool    `json:"overdue_and_needs_submission"`
+	QuizPointsPossible        float64 `json:"quiz_points_possible"`
+	ValidationToken           string  `json:"validation_token"`
+	HTMLURL                   string  `json:"html_url"`
+	ResultURL                 string  `json:"result_url"`
+}
+
+// QuizSubmissionQuestion is a question of a quiz as it is presented in an attempt
+type QuizSubmissionQuestion struct {
+	QuizQuestion
+	Flagged bool `json:"flagged"`
+	// Answer is the answer given so far, its shape depends on the question type
+	Answer json.RawMessage `json:"answer"`
+}
+
+// QuizQuestionAnswer is the answer to a question of a quiz submission.
+// Answer is an answer id for multiple choice and true/false questions, a slice of answer ids
+// for multiple answers questions, text for short answer and essay questions, a number for
+// numerical and calculated questions, a map of blank names to text or answer ids for fill in
+// multiple blanks and multiple dropdowns questions, and a slice of answer_id and match_id
+// pairs for matching questions
+type QuizQuestionAnswer struct {
+	ID     int64       `json:"id"`
+	Answer interface{} `json:"answer"`
+}
+
+// QuizQuestionScore is the score and comment a grader gives to a question of a quiz submission
+type QuizQuestionScore struct {
+	Score   float64 `json:"score"`
+	Comment string  `json:"comment,omitempty"`
+}
+
+// QuizSubmissionEvent is an event recorded while a student was taking a quiz
+type QuizSubmissionEvent struct {
+	// ID is sent as a string by canvas
+	ID        json.Number     `json:"id"`
+	EventType string          `json:"event_type"`
+	CreatedAt string          `json:"created_at"`
+	EventData json.RawMessage `json:"event_data"`
+}
+
+// QuizSubmissionTime is the time left in a timed quiz submission
+type QuizSubmissionTime struct {
+	EndAt    string `json:"end_at"`
+	TimeLeft int64  `json:"time_left"`
+}
+
+// quizSubmissionsResponse is the envelope canvas wraps quiz submissions in
+type quizSubmissionsResponse struct {
+	QuizSubmissions []QuizSubmission `json:"quiz_submissions"`
+}
+
+// first returns the first submission of the envelope
+func (r *quizSubmissionsResponse) first() *QuizSubmission {
+	if len(r.QuizSubmissions) == 0 {
+		return &QuizSubmission{}
+	}
+	return &r.QuizSubmissions[0]
+}
+
+// quizSubmissionQuestionsResponse is the envelope canvas wraps quiz submission questions in
+type quizSubmissionQuestionsResponse struct {
+	QuizSubmissionQuestions []QuizSubmissionQuestion `json:"quiz_submission_questions"`
+}
+
+// quizSubmissionEventsResponse is the envelope canvas wraps quiz submission events in
+type quizSubmissionEventsResponse struct {
+	QuizSubmissionEvents []QuizSubmissionEvent `json:"quiz_submission_events"`
+}
+
+// GetQuizSubmissions returns every submission to the quiz
+func (c *CanvasClient) GetQuizSubmissions(courseID int64, quizID int64) ([]QuizSubmission, error) {
+	pages := make([]quizSubmissionsResponse, 0)
+	s := make([]QuizSubmission, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions", c.ClientURL(), courseID, quizID)
+	err := c.getPaginatedObjects(requestURL, &pages)
+
+	if err != nil {
+		return s, err
+	}
+
+	for _, page := range pages {
+		s = append(s, page.QuizSubmissions...)
+	}
+
+	return s, nil
+}
+
+// GetQuizSubmission returns the quiz submission with the given submissionID
+func (c *CanvasClient) GetQuizSubmission(courseID int64, quizID int64, submissionID int64) (*QuizSubmission, error) {
+	res := quizSubmissionsResponse{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions/%d", c.ClientURL(), courseID, quizID, submissionID)
+	err := c.getJSON(requestURL, &res)
+
+	if err != nil {
+		return &QuizSubmission{}, err
+	}
+
+	return res.first(), nil
+}
+
+// GetCurrentQuizSubmission returns the submission of the user to the quiz
+func (c *CanvasClient) GetCurrentQuizSubmission(courseID int64, quizID int64) (*QuizSubmission, error) {
+	res := quizSubmissionsResponse{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submission", c.ClientURL(), courseID, quizID)
+	err := c.getJSON(requestURL, &res)
+
+	if err != nil {
+		return &QuizSubmission{}, err
+	}
+
+	return res.first(), nil
+}
+
+// StartQuizSubmission starts a new attempt at the quiz.
+// When preview is set, the attempt is a teacher preview that is not graded
+func (c *CanvasClient) StartQuizSubmission(courseID int64, quizID int64, accessCode string, preview bool) (*QuizSubmission, error) {
+	res := quizSubmissionsResponse{}
+
+	form := url.Values{}
+	if accessCode != "" {
+		form.Add("access_code", accessCode)
+	}
+	if preview {
+		form.Add("preview", "true")
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions", c.ClientURL(), courseID, quizID)
+	err := c.sendJSON("POST", requestURL, form, &res)
+
+	if err != nil {
+		return &QuizSubmission{}, err
+	}
+
+	return res.first(), nil
+}
+
+// GetQuizSubmissionQuestions returns the questions of the attempt with the answers given so far
+func (c *CanvasClient) GetQuizSubmissionQuestions(submissionID int64) ([]QuizSubmissionQuestion, error) {
+	res := quizSubmissionQuestionsResponse{QuizSubmissionQuestions: make([]QuizSubmissionQuestion, 0)}
+
+	requestURL := fmt.Sprintf("%s/api/v1/quiz_submissions/%d/questions?include[]=quiz_question", c.ClientURL(), submissionID)
+	err := c.getJSON(requestURL, &res)
+
+	if err != nil {
+		return res.QuizSubmissionQuestions, err
+	}
+
+	return res.QuizSubmissionQuestions, nil
+}
+
+// AnswerQuizQuestions saves the answers to questions of the attempt
+func (c *CanvasClient) AnswerQuizQuestions(submission *QuizSubmission, accessCode string, answers ...QuizQuestionAnswer) ([]QuizSubmissionQuestion, error) {
+	res := quizSubmissionQuestionsResponse{QuizSubmissionQuestions: make([]QuizSubmissionQuestion, 0)}
+
+	payload := map[string]interface{}{
+		"attempt":          submission.Attempt,
+		"validation_token": submission.ValidationToken,
+		"quiz_questions":   answers,
+	}
+	if accessCode != "" {
+		payload["access_code"] = accessCode
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/quiz_submissions/%d/questions", c.ClientURL(), submission.ID)
+	err := c.sendJSONBody("POST", requestURL, payload, &res)
+
+	if err != nil {
+		return res.QuizSubmissionQuestions, err
+	}
+
+	return res.QuizSubmissionQuestions, nil
+}
+
+// FlagQuizQuestion flags or unflags the question of the attempt for later review
+func (c *CanvasClient) FlagQuizQuestion(submission *QuizSubmission, questionID int64, flagged bool, accessCode string) error {
+	action := "unflag"
+	if flagged {
+		action = "flag"
+	}
+
+	form := url.Values{}
+	form.Add("attempt", strconv.FormatInt(submission.Attempt, 10))
+	form.Add("validation_token", submission.ValidationToken)
+	if accessCode != "" {
+		form.Add("access_code", accessCode)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/quiz_submissions/%d/questions/%d/%s", c.ClientURL(), submission.ID, questionID, action)
+
+	return c.sendJSON("PUT", requestURL, form, nil)
+}
+
+// CompleteQuizSubmission turns in the attempt for grading
+func (c *CanvasClient) CompleteQuizSubmission(courseID int64, submission *QuizSubmission, accessCode string) (*QuizSubmission, error) {
+	res := quizSubmissionsResponse{}
+
+	form := url.Values{}
+	form.Add("attempt", strconv.FormatInt(submission.Attempt, 10))
+	form.Add("validation_token", submission.ValidationToken)
+	if accessCode != "" {
+		form.Add("access_code", accessCode)
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions/%d/complete", c.ClientURL(), courseID, submission.QuizID, submission.ID)
+	err := c.sendJSON("POST", requestURL, form, &res)
+
+	if err != nil {
+		return &QuizSubmission{}, err
+	}
+
+	return res.first(), nil
+}
+
+// GetQuizSubmissionTime returns the time left in the attempt
+func (c *CanvasClient) GetQuizSubmissionTime(submissionID int64) (*QuizSubmissionTime, error) {
+	t := QuizSubmissionTime{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/quiz_submissions/%d/time", c.ClientURL(), submissionID)
+	err := c.getJSON(requestURL, &t)
+
+	if err != nil {
+		return &t, err
+	}
+
+	return &t, nil
+}
+
+// GetQuizSubmissionEvents returns the events recorded during the attempt of the submission
+func (c *CanvasClient) GetQuizSubmissionEvents(courseID int64, submission *QuizSubmission) ([]QuizSubmissionEvent, error) {
+	pages := make([]quizSubmissionEventsResponse, 0)
+	e := make([]QuizSubmissionEvent, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions/%d/events?attempt=%d",
+		c.ClientURL(), courseID, submission.QuizID, submission.ID, submission.Attempt)
+	err := c.getPaginatedObjects(requestURL, &pages)
+
+	if err != nil {
+		return e, err
+	}
+
+	for _, page := range pages {
+		e = append(e, page.QuizSubmissionEvents...)
+	}
+
+	return e, nil
+}
+
+// UpdateQuizSubmissionScores sets the fudge points of the submission and
+// the scores and comments of its questions, keyed by question id
+func (c *CanvasClient) UpdateQuizSubmissionScores(courseID int64, submission *QuizSubmission, scores map[int64]QuizQuestionScore) (*QuizSubmission, error) {
+	res := quizSubmissionsResponse{}
+
+	questions := make(map[string]QuizQuestionScore)
+	for id, score := range scores {
+		questions[strconv.FormatInt(id, 10)] = score
+	}
+
+	payload := map[string]interface{}{
+		"quiz_submissions": []map[string]interface{}{
+			{
+				"attempt":      submission.Attempt,
+				"fudge_points": submission.FudgePoints,
+				"questions":    questions,
+			},
+		},
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/submissions/%d", c.ClientURL(), courseID, submission.QuizID, submission.ID)
+	err := c.sendJSONBody("PUT", requestURL, payload, &res)
+
+	if err != nil {
+		return &QuizSubmission{}, err
+	}
+
+	return res.first(), nil
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_StartQuizSubmission(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/quizzes/3/submissions").
+		BodyString("access_code=secret").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"quiz_submissions": []QuizSubmission{{ID: 40, QuizID: 3, Attempt: 1, ValidationToken: "token"}},
+		})
+
+	got, err := client.StartQuizSubmission(5, 3, "secret", false)
+	assert.Nil(t, err)
+	assert.Equal(t, &QuizSubmission{ID: 40, QuizID: 3, Attempt: 1, ValidationToken: "token"}, got)
+}
+
+func TestCanvasClient_AnswerQuizQuestions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/quiz_submissions/40/questions").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"attempt":          1,
+			"validation_token": "token",
+			"quiz_questions": []map[string]interface{}{
+				{"id": 11, "answer": 3866},
+				{"id": 12, "answer": []int64{1, 2}},
+			},
+		}).
+		Reply(200).
+		BodyString(`{"quiz_submission_questions": [
+			{"id": 11, "flagged": false, "answer": 3866, "question_type": "multiple_choice_question"},
+			{"id": 12, "flagged": true, "answer": ["1", "2"]}
+		]}`)
+
+	submission := &QuizSubmission{ID: 40, Attempt: 1, ValidationToken: "token"}
+	got, err := client.AnswerQuizQuestions(submission, "",
+		QuizQuestionAnswer{ID: 11, Answer: 3866},
+		QuizQuestionAnswer{ID: 12, Answer: []int64{1, 2}},
+	)
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, int64(11), got[0].ID)
+	assert.Equal(t, MultipleChoiceQuestion, got[0].QuestionType)
+	assert.True(t, got[1].Flagged)
+	assert.JSONEq(t, `["1", "2"]`, string(got[1].Answer))
+}
+
+func TestCanvasClient_UpdateQuizSubmissionScores(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/quizzes/3/submissions/40").
+		JSON(map[string]interface{}{
+			"quiz_submissions": []map[string]interface{}{{
+				"attempt":      1,
+				"fudge_points": -2.5,
+				"questions":    map[string]interface{}{"11": map[string]interface{}{"score": 1, "comment": "close"}},
+			}},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"quiz_submissions": []QuizSubmission{{ID: 40, Score: 7.5, FudgePoints: -2.5}},
+		})
+
+	submission := &QuizSubmission{ID: 40, QuizID: 3, Attempt: 1, FudgePoints: -2.5}
+	got, err := client.UpdateQuizSubmissionScores(5, submission, map[int64]QuizQuestionScore{
+		11: {Score: 1, Comment: "close"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 7.5, got.Score)
+}
+
+func TestCanvasClient_GetQuizSubmissionEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/quizzes/3/submissions/40/events").
+		MatchParam("attempt", "1").
+		Reply(200).
+		BodyString(`{"quiz_submission_events": [
+			{"id": "3409", "event_type": "page_blurred", "event_data": null, "created_at": "2021-03-02T15:00:00Z"}
+		]}`)
+
+	got, err := client.GetQuizSubmissionEvents(5, &QuizSubmission{ID: 40, QuizID: 3, Attempt: 1})
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "3409", got[0].ID.String())
+	assert.Equal(t, "page_blurred", got[0].EventType)
+}
@@ -0,0 +1,704 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Question types of a classic quiz
+const (
+	CalculatedQuestion           = "calculated_question"
+	EssayQuestion                = "essay_question"
+	FileUploadQuestion           = "file_upload_question"
+	FillInMultipleBlanksQuestion = "fill_in_multiple_blanks_question"
+	MatchingQuestion             = "matching_question"
+	MultipleAnswersQuestion      = "multiple_answers_question"
+	MultipleChoiceQuestion       = "multiple_choice_question"
+	MultipleDropdownsQuestion    = "multiple_dropdowns_question"
+	NumericalQuestion            = "numerical_question"
+	ShortAnswerQuestion          = "short_answer_question"
+	TextOnlyQuestion             = "text_only_question"
+	TrueFalseQuestion            = "true_false_question"
+)
+
+// Quiz is a classic quiz
+type Quiz struct {
+	ID                            int64    `json:"id"`
+	Title                         string   `json:"title"`
+	HTMLURL                       string   `json:"html_url"`
+	MobileURL                     string   `json:"mobile_url"`
+	PreviewURL                    string   `json:"preview_url"`
+	Description                   string   `json:"description"`
+	QuizType                      string   `json:"quiz_type"`
+	AssignmentGroupID             int64    `json:"assignment_group_id"`
+	AssignmentID                  int64    `json:"assignment_id"`
+	TimeLimit                     int64    `json:"time_limit"`
+	ShuffleAnswers                bool     `json:"shuffle_answers"`
+	HideResults                   string   `json:"hide_results"`
+	ShowCorrectAnswers            bool     `json:"show_correct_answers"`
+	ShowCorrectAnswersLastAttempt bool     `json:"show_correct_answers_last_attempt"`
+	ShowCorrectAnswersAt          string   `json:"show_correct_answers_at"`
+	HideCorrectAnswersAt          string   `json:"hide_correct_answers_at"`
+	OneTimeResults                bool     `json:"one_time_results"`
+	ScoringPolicy                 string   `json:"scoring_policy"`
+	AllowedAttempts               int64    `json:"allowed_attempts"`
+	OneQuestionAtATime            bool     `json:"one_question_at_a_time"`
+	QuestionCount                 int64    `json:"question_count"`
+	PointsPossible                float64  `json:"points_possible"`
+	CantGoBack                    bool     `json:"cant_go_back"`
+	AccessCode                    string   `json:"access_code"`
+	IPFilter                      string   `json:"ip_filter"`
+	DueAt                         string   `json:"due_at"`
+	LockAt                        string   `json:"lock_at"`
+	UnlockAt                      string   `json:"unlock_at"`
+	Published                     bool     `json:"published"`
+	Unpublishable                 bool     `json:"unpublishable"`
+	LockedForUser                 bool     `json:"locked_for_user"`
+	LockExplanation               string   `json:"lock_explanation"`
+	SpeedgraderURL                string   `json:"speedgrader_url"`
+	QuizExtensionsURL             string   `json:"quiz_extensions_url"`
+	VersionNumber                 int64    `json:"version_number"`
+	QuestionTypes                 []string `json:"question_types"`
+	AnonymousSubmissions          bool     `json:"anonymous_submissions"`
+}
+
+// QuizQuestion is a question of a classic quiz
+type QuizQuestion struct {
+	ID                   int64          `json:"id"`
+	QuizID               int64          `json:"quiz_id"`
+	QuizGroupID          int64          `json:"quiz_group_id"`
+	Position             int64          `json:"position"`
+	QuestionName         string         `json:"question_name"`
+	QuestionType         string         `json:"question_type"`
+	QuestionText         string         `json:"question_text"`
+	PointsPossible       float64        `json:"points_possible"`
+	CorrectComments      string         `json:"correct_comments"`
+	IncorrectComments    string         `json:"incorrect_comments"`
+	NeutralComments      string         `json:"neutral_comments"`
+	TextAfterAnswers     string         `json:"text_after_answers"`
+	Answers              []QuizAnswer   `json:"answers"`
+	Matches              []QuizMatch    `json:"matches"`
+	Variables            []QuizVariable `json:"variables"`
+	Formulas             []QuizFormula  `json:"formulas"`
+	AnswerTolerance      float64        `json:"answer_tolerance"`
+	FormulaDecimalPlaces int64          `json:"formula_decimal_places"`
+}
+
+// QuizAnswer is a possible answer to a quiz question.
+// Which fields are used depends on the type of the question
+type QuizAnswer struct {
+	ID                             int64   `json:"id"`
+	AnswerText                     string  `json:"answer_text"`
+	AnswerHTML                     string  `json:"answer_html"`
+	AnswerWeight                   float64 `json:"answer_weight"`
+	AnswerComments                 string  `json:"answer_comments"`
+	TextAfterAnswers               string  `json:"text_after_answers"`
+	AnswerMatchLeft                string  `json:"answer_match_left"`
+	AnswerMatchRight               string  `json:"answer_match_right"`
+	MatchingAnswerIncorrectMatches string  `json:"matching_answer_incorrect_matches"`
+	NumericalAnswerType            string  `json:"numerical_answer_type"`
+	Exact                          float64 `json:"exact"`
+	Margin                         float64 `json:"margin"`
+	Approximate                    float64 `json:"approximate"`
+	Precision                      int64   `json:"precision"`
+	Start                          float64 `json:"start"`
+	End                            float64 `json:"end"`
+	BlankID                        string  `json:"blank_id"`
+}
+
+// QuizMatch is a right-hand side option of a matching question
+type QuizMatch struct {
+	MatchID int64  `json:"match_id"`
+	Text    string `json:"text"`
+}
+
+// QuizVariable is a variable of a calculated question
+type QuizVariable struct {
+	Name  string  `json:"name"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Scale int64   `json:"scale"`
+}
+
+// QuizFormula is a formula of a calculated question
+type QuizFormula struct {
+	Formula string `json:"formula"`
+}
+
+// QuizGroup is a group of questions from which a number is picked for each attempt
+type QuizGroup struct {
+	ID                       int64   `json:"id"`
+	QuizID                   int64   `json:"quiz_id"`
+	Name                     string  `json:"name"`
+	PickCount                int64   `json:"pick_count"`
+	QuestionPoints           float64 `json:"question_points"`
+	AssessmentQuestionBankID int64   `json:"assessment_question_bank_id"`
+	Position                 int64   `json:"position"`
+}
+
+// QuizExtension gives a student extra time or attempts on a quiz
+type QuizExtension struct {
+	QuizID           int64  `json:"quiz_id"`
+	UserID           int64  `json:"user_id"`
+	ExtraAttempts    int64  `json:"extra_attempts"`
+	ExtraTime        int64  `json:"extra_time"`
+	ManuallyUnlocked bool   `json:"manually_unlocked"`
+	EndAt            string `json:"end_at"`
+	// ExtendFromNow extends the current attempt by this many minutes from now
+	ExtendFromNow int64 `json:"-"`
+	// ExtendFromEndAt extends the current attempt by this many minutes from when it would end
+	ExtendFromEndAt int64 `json:"-"`
+}
+
+// QuizStatistics is the statistics of the submissions of a quiz
+type QuizStatistics struct {
+	// ID is sent as a string by canvas
+	ID                    json.Number              `json:"id"`
+	MultipleAttemptsExist bool                     `json:"multiple_attempts_exist"`
+	IncludesAllVersions   bool                     `json:"includes_all_versions"`
+	GeneratedAt           string                   `json:"generated_at"`
+	URL                   string                   `json:"url"`
+	HTMLURL               string                   `json:"html_url"`
+	QuestionStatistics    []QuizQuestionStatistics `json:"question_statistics"`
+	SubmissionStatistics  QuizSubmissionStatistics `json:"submission_statistics"`
+}
+
+// QuizQuestionStatistics is the statistics of the responses to a quiz question
+type QuizQuestionStatistics struct {
+	// ID is the id of the question, sent as a string by canvas
+	ID                        json.Number            `json:"id"`
+	QuestionType              string                 `json:"question_type"`
+	QuestionText              string                 `json:"question_text"`
+	Position                  int64                  `json:"position"`
+	Responses                 int64                  `json:"responses"`
+	AnsweredStudentCount      int64                  `json:"answered_student_count"`
+	TopStudentCount           int64                  `json:"top_student_count"`
+	MiddleStudentCount        int64                  `json:"middle_student_count"`
+	BottomStudentCount        int64                  `json:"bottom_student_count"`
+	CorrectStudentCount       int64                  `json:"correct_student_count"`
+	IncorrectStudentCount     int64                  `json:"incorrect_student_count"`
+	PartiallyCorrectCount     int64                  `json:"partially_correct_student_count"`
+	CorrectStudentRatio       float64                `json:"correct_student_ratio"`
+	IncorrectStudentRatio     float64                `json:"incorrect_student_ratio"`
+	CorrectTopStudentCount    int64                  `json:"correct_top_student_count"`
+	CorrectBottomStudentCount int64                  `json:"correct_bottom_student_count"`
+	Answers                   []QuizAnswerStatistics `json:"answers"`
+}
+
+// QuizAnswerStatistics is the number of students that chose an answer
+type QuizAnswerStatistics struct {
+	// ID is the answer id, or "none" and "other" for missing and unexpected answers
+	ID        string  `json:"id"`
+	Text      string  `json:"text"`
+	Correct   bool    `json:"correct"`
+	Responses int64   `json:"responses"`
+	UserIDs   []int64 `json:"user_ids"`
+}
+
+// QuizSubmissionStatistics summarizes the scores of the submissions of a quiz
+type QuizSubmissionStatistics struct {
+	UniqueCount           int64            `json:"unique_count"`
+	ScoreAverage          float64          `json:"score_average"`
+	ScoreHigh             float64          `json:"score_high"`
+	ScoreLow              float64          `json:"score_low"`
+	ScoreStdev            float64          `json:"score_stdev"`
+	Scores                map[string]int64 `json:"scores"`
+	CorrectCountAverage   float64          `json:"correct_count_average"`
+	IncorrectCountAverage float64          `json:"incorrect_count_average"`
+	DurationAverage       float64          `json:"duration_average"`
+}
+
+// GetQuizzes returns the quizzes of a course.
+// An empty searchTerm returns every quiz
+func (c *CanvasClient) GetQuizzes(courseID int64, searchTerm string) ([]Quiz, error) {
+	q := make([]Quiz, 0)
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/courses/%d/quizzes", c.ClientURL(), courseID))
+
+	if err != nil {
+		return q, err
+	}
+
+	if searchTerm != "" {
+		query := parsedURL.Query()
+		query.Add("search_term", searchTerm)
+		parsedURL.RawQuery = query.Encode()
+	}
+
+	err = c.getPaginatedJSON(parsedURL.String(), &q)
+
+	if err != nil {
+		return q, err
+	}
+
+	return q, nil
+}
+
+// GetQuiz returns the quiz with the given quizID
+func (c *CanvasClient) GetQuiz(courseID int64, quizID int64) (*Quiz, error) {
+	quiz := Quiz{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d", c.ClientURL(), courseID, quizID)
+	err := c.getJSON(requestURL, &quiz)
+
+	if err != nil {
+		return &quiz, err
+	}
+
+	return &quiz, nil
+}
+
+// CreateQuiz creates a quiz in the course
+func (c *CanvasClient) CreateQuiz(courseID int64, quiz *Quiz) (*Quiz, error) {
+	created := Quiz{}
+
+	form, err := quizForm(quiz, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes", c.ClientURL(), courseID)
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateQuiz updates the named fields of the quiz, such as "title" or "due_at", to match the given one
+func (c *CanvasClient) UpdateQuiz(courseID int64, quiz *Quiz, fields ...string) (*Quiz, error) {
+	updated := Quiz{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := quizForm(quiz, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d", c.ClientURL(), courseID, quiz.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteQuiz deletes the quiz with the given quizID and returns it
+func (c *CanvasClient) DeleteQuiz(courseID int64, quizID int64) (*Quiz, error) {
+	deleted := Quiz{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d", c.ClientURL(), courseID, quizID)
+	err := c.sendJSON("DELETE", requestURL, nil, &deleted)
+
+	if err != nil {
+		return &deleted, err
+	}
+
+	return &deleted, nil
+}
+
+func quizForm(quiz *Quiz, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("title", true) {
+		form.Add("quiz[title]", quiz.Title)
+	}
+	if fields.has("description", true) {
+		form.Add("quiz[description]", quiz.Description)
+	}
+	if fields.has("quiz_type", quiz.QuizType != "") {
+		form.Add("quiz[quiz_type]", quiz.QuizType)
+	}
+	if fields.has("assignment_group_id", quiz.AssignmentGroupID != 0) {
+		form.Add("quiz[assignment_group_id]", strconv.FormatInt(quiz.AssignmentGroupID, 10))
+	}
+	if fields.has("time_limit", quiz.TimeLimit != 0) {
+		form.Add("quiz[time_limit]", strconv.FormatInt(quiz.TimeLimit, 10))
+	}
+	if fields.has("shuffle_answers", true) {
+		form.Add("quiz[shuffle_answers]", strconv.FormatBool(quiz.ShuffleAnswers))
+	}
+	if fields.has("hide_results", quiz.HideResults != "") {
+		form.Add("quiz[hide_results]", quiz.HideResults)
+	}
+	if fields.has("show_correct_answers", true) {
+		form.Add("quiz[show_correct_answers]", strconv.FormatBool(quiz.ShowCorrectAnswers))
+	}
+	if fields.has("show_correct_answers_last_attempt", true) {
+		form.Add("quiz[show_correct_answers_last_attempt]", strconv.FormatBool(quiz.ShowCorrectAnswersLastAttempt))
+	}
+	if fields.has("show_correct_answers_at", quiz.ShowCorrectAnswersAt != "") {
+		form.Add("quiz[show_correct_answers_at]", quiz.ShowCorrectAnswersAt)
+	}
+	if fields.has("hide_correct_answers_at", quiz.HideCorrectAnswersAt != "") {
+		form.Add("quiz[hide_correct_answers_at]", quiz.HideCorrectAnswersAt)
+	}
+	if fields.has("allowed_attempts", quiz.AllowedAttempts != 0) {
+		form.Add("quiz[allowed_attempts]", strconv.FormatInt(quiz.AllowedAttempts, 10))
+	}
+	if fields.has("scoring_policy", quiz.ScoringPolicy != "") {
+		form.Add("quiz[scoring_policy]", quiz.ScoringPolicy)
+	}
+	if fields.has("one_question_at_a_time", true) {
+		form.Add("quiz[one_question_at_a_time]", strconv.FormatBool(quiz.OneQuestionAtATime))
+	}
+	if fields.has("cant_go_back", true) {
+		form.Add("quiz[cant_go_back]", strconv.FormatBool(quiz.CantGoBack))
+	}
+	if fields.has("one_time_results", true) {
+		form.Add("quiz[one_time_results]", strconv.FormatBool(quiz.OneTimeResults))
+	}
+	if fields.has("access_code", quiz.AccessCode != "") {
+		form.Add("quiz[access_code]", quiz.AccessCode)
+	}
+	if fields.has("ip_filter", quiz.IPFilter != "") {
+		form.Add("quiz[ip_filter]", quiz.IPFilter)
+	}
+	if fields.has("due_at", true) {
+		form.Add("quiz[due_at]", quiz.DueAt)
+	}
+	if fields.has("lock_at", true) {
+		form.Add("quiz[lock_at]", quiz.LockAt)
+	}
+	if fields.has("unlock_at", true) {
+		form.Add("quiz[unlock_at]", quiz.UnlockAt)
+	}
+	if fields.has("published", true) {
+		form.Add("quiz[published]", strconv.FormatBool(quiz.Published))
+	}
+	return form, fields.unknown()
+}
+
+// GetQuizQuestions returns the questions of the quiz
+func (c *CanvasClient) GetQuizQuestions(courseID int64, quizID int64) ([]QuizQuestion, error) {
+	q := make([]QuizQuestion, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/questions", c.ClientURL(), courseID, quizID)
+	err := c.getPaginatedJSON(requestURL, &q)
+
+	if err != nil {
+		return q, err
+	}
+
+	return q, nil
+}
+
+// GetQuizQuestion returns the question with the given questionID
+func (c *CanvasClient) GetQuizQuestion(courseID int64, quizID int64, questionID int64) (*QuizQuestion, error) {
+	question := QuizQuestion{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/questions/%d", c.ClientURL(), courseID, quizID, questionID)
+	err := c.getJSON(requestURL, &question)
+
+	if err != nil {
+		return &question, err
+	}
+
+	return &question, nil
+}
+
+// CreateQuizQuestion adds the question to the quiz
+func (c *CanvasClient) CreateQuizQuestion(courseID int64, quizID int64, question *QuizQuestion) (*QuizQuestion, error) {
+	created := QuizQuestion{}
+
+	form, err := quizQuestionForm(question, nil)
+
+	if err != nil {
+		return &created, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/questions", c.ClientURL(), courseID, quizID)
+	err = c.sendJSON("POST", requestURL, form, &created)
+
+	if err != nil {
+		return &created, err
+	}
+
+	return &created, nil
+}
+
+// UpdateQuizQuestion updates the named fields of the question, such as "question_text" or "answers", to match the given one
+func (c *CanvasClient) UpdateQuizQuestion(courseID int64, quizID int64, question *QuizQuestion, fields ...string) (*QuizQuestion, error) {
+	updated := QuizQuestion{}
+
+	f, err := updateFields(fields)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	form, err := quizQuestionForm(question, f)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/questions/%d", c.ClientURL(), courseID, quizID, question.ID)
+	err = c.sendJSON("PUT", requestURL, form, &updated)
+
+	if err != nil {
+		return &updated, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteQuizQuestion removes the question with the given questionID from the quiz
+func (c *CanvasClient) DeleteQuizQuestion(courseID int64, quizID int64, questionID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/questions/%d", c.ClientURL(), courseID, quizID, questionID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+func quizQuestionForm(question *QuizQuestion, fields formFields) (url.Values, error) {
+	form := url.Values{}
+	if fields.has("question_name", true) {
+		form.Add("question[question_name]", question.QuestionName)
+	}
+	if fields.has("question_text", true) {
+		form.Add("question[question_text]", question.QuestionText)
+	}
+	if fields.has("question_type", true) {
+		form.Add("question[question_type]", question.QuestionType)
+	}
+	if fields.has("points_possible", true) {
+		form.Add("question[points_possible]", strconv.FormatFloat(question.PointsPossible, 'f', -1, 64))
+	}
+	if fields.has("position", question.Position != 0) {
+		form.Add("question[position]", strconv.FormatInt(question.Position, 10))
+	}
+	if fields.has("quiz_group_id", question.QuizGroupID != 0) {
+		form.Add("question[quiz_group_id]", strconv.FormatInt(question.QuizGroupID, 10))
+	}
+	if fields.has("correct_comments", question.CorrectComments != "") {
+		form.Add("question[correct_comments]", question.CorrectComments)
+	}
+	if fields.has("incorrect_comments", question.IncorrectComments != "") {
+		form.Add("question[incorrect_comments]", question.IncorrectComments)
+	}
+	if fields.has("neutral_comments", question.NeutralComments != "") {
+		form.Add("question[neutral_comments]", question.NeutralComments)
+	}
+	if fields.has("text_after_answers", question.TextAfterAnswers != "") {
+		form.Add("question[text_after_answers]", question.TextAfterAnswers)
+	}
+
+	answers := question.Answers
+	if !fields.has("answers", true) {
+		answers = nil
+	}
+	for i, answer := range answers {
+		key := func(name string) string {
+			return fmt.Sprintf("question[answers][%d][%s]", i, name)
+		}
+		addString := func(name string, value string) {
+			if value != "" {
+				form.Add(key(name), value)
+			}
+		}
+		addFloat := func(name string, value float64) {
+			if value != 0 {
+				form.Add(key(name), strconv.FormatFloat(value, 'f', -1, 64))
+			}
+		}
+
+		if answer.ID != 0 {
+			form.Add(key("id"), strconv.FormatInt(answer.ID, 10))
+		}
+		form.Add(key("answer_weight"), strconv.FormatFloat(answer.AnswerWeight, 'f', -1, 64))
+		addString("answer_text", answer.AnswerText)
+		addString("answer_html", answer.AnswerHTML)
+		addString("answer_comments", answer.AnswerComments)
+		addString("text_after_answers", answer.TextAfterAnswers)
+		addString("answer_match_left", answer.AnswerMatchLeft)
+		addString("answer_match_right", answer.AnswerMatchRight)
+		addString("matching_answer_incorrect_matches", answer.MatchingAnswerIncorrectMatches)
+		addString("numerical_answer_type", answer.NumericalAnswerType)
+		addString("blank_id", answer.BlankID)
+		addFloat("exact", answer.Exact)
+		addFloat("margin", answer.Margin)
+		addFloat("approximate", answer.Approximate)
+		addFloat("start", answer.Start)
+		addFloat("end", answer.End)
+		if answer.Precision != 0 {
+			form.Add(key("precision"), strconv.FormatInt(answer.Precision, 10))
+		}
+	}
+
+	variables := question.Variables
+	if !fields.has("variables", true) {
+		variables = nil
+	}
+	for i, variable := range variables {
+		key := fmt.Sprintf("question[variables][%d]", i)
+		form.Add(key+"[name]", variable.Name)
+		form.Add(key+"[min]", strconv.FormatFloat(variable.Min, 'f', -1, 64))
+		form.Add(key+"[max]", strconv.FormatFloat(variable.Max, 'f', -1, 64))
+		form.Add(key+"[scale]", strconv.FormatInt(variable.Scale, 10))
+	}
+	formulas := question.Formulas
+	if !fields.has("formulas", true) {
+		formulas = nil
+	}
+	for i, formula := range formulas {
+		form.Add(fmt.Sprintf("question[formulas][%d][formula]", i), formula.Formula)
+	}
+	calculated := question.QuestionType == CalculatedQuestion
+	if fields.has("answer_tolerance", calculated) {
+		form.Add("question[answer_tolerance]", strconv.FormatFloat(question.AnswerTolerance, 'f', -1, 64))
+	}
+	if fields.has("formula_decimal_places", calculated) {
+		form.Add("question[formula_decimal_places]", strconv.FormatInt(question.FormulaDecimalPlaces, 10))
+	}
+
+	return form, fields.unknown()
+}
+
+// quizGroupsResponse is the envelope canvas wraps quiz groups in
+type quizGroupsResponse struct {
+	QuizGroups []QuizGroup `json:"quiz_groups"`
+}
+
+// GetQuizGroup returns the question group with the given groupID
+func (c *CanvasClient) GetQuizGroup(courseID int64, quizID int64, groupID int64) (*QuizGroup, error) {
+	group := QuizGroup{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/groups/%d", c.ClientURL(), courseID, quizID, groupID)
+	err := c.getJSON(requestURL, &group)
+
+	if err != nil {
+		return &group, err
+	}
+
+	return &group, nil
+}
+
+// CreateQuizGroup adds a question group to the quiz.
+// Setting AssessmentQuestionBankID links the group to a question bank
+func (c *CanvasClient) CreateQuizGroup(courseID int64, quizID int64, group *QuizGroup) (*QuizGroup, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/groups", c.ClientURL(), courseID, quizID)
+
+	return c.sendQuizGroup("POST", requestURL, group)
+}
+
+// UpdateQuizGroup updates the name, pick count and question points of the group
+func (c *CanvasClient) UpdateQuizGroup(courseID int64, quizID int64, group *QuizGroup) (*QuizGroup, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/groups/%d", c.ClientURL(), courseID, quizID, group.ID)
+
+	return c.sendQuizGroup("PUT", requestURL, group)
+}
+
+func (c *CanvasClient) sendQuizGroup(method string, requestURL string, group *QuizGroup) (*QuizGroup, error) {
+	res := quizGroupsResponse{}
+
+	form := url.Values{}
+	form.Add("quiz_groups[][name]", group.Name)
+	form.Add("quiz_groups[][pick_count]", strconv.FormatInt(group.PickCount, 10))
+	form.Add("quiz_groups[][question_points]", strconv.FormatFloat(group.QuestionPoints, 'f', -1, 64))
+	if group.AssessmentQuestionBankID != 0 {
+		form.Add("quiz_groups[][assessment_question_bank_id]", strconv.FormatInt(group.AssessmentQuestionBankID, 10))
+	}
+
+	err := c.sendJSON(method, requestURL, form, &res)
+
+	if err != nil {
+		return &QuizGroup{}, err
+	}
+
+	if len(res.QuizGroups) == 0 {
+		return &QuizGroup{}, nil
+	}
+
+	return &res.QuizGroups[0], nil
+}
+
+// DeleteQuizGroup removes the question group with the given groupID from the quiz
+func (c *CanvasClient) DeleteQuizGroup(courseID int64, quizID int64, groupID int64) error {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/groups/%d", c.ClientURL(), courseID, quizID, groupID)
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
+
+// quizExtensionsResponse is the envelope canvas wraps quiz extensions in
+type quizExtensionsResponse struct {
+	QuizExtensions []QuizExtension `json:"quiz_extensions"`
+}
+
+// SetQuizExtensions gives the students in the extensions extra time or attempts on the quiz
+func (c *CanvasClient) SetQuizExtensions(courseID int64, quizID int64, extensions []QuizExtension) ([]QuizExtension, error) {
+	res := quizExtensionsResponse{QuizExtensions: make([]QuizExtension, 0)}
+
+	form := url.Values{}
+	for i, extension := range extensions {
+		key := func(name string) string {
+			return fmt.Sprintf("quiz_extensions[%d][%s]", i, name)
+		}
+
+		form.Add(key("user_id"), strconv.FormatInt(extension.UserID, 10))
+		form.Add(key("extra_attempts"), strconv.FormatInt(extension.ExtraAttempts, 10))
+		form.Add(key("extra_time"), strconv.FormatInt(extension.ExtraTime, 10))
+		form.Add(key("manually_unlocked"), strconv.FormatBool(extension.ManuallyUnlocked))
+		if extension.ExtendFromNow != 0 {
+			form.Add(key("extend_from_now"), strconv.FormatInt(extension.ExtendFromNow, 10))
+		}
+		if extension.ExtendFromEndAt != 0 {
+			form.Add(key("extend_from_end_at"), strconv.FormatInt(extension.ExtendFromEndAt, 10))
+		}
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/extensions", c.ClientURL(), courseID, quizID)
+	err := c.sendJSON("POST", requestURL, form, &res)
+
+	if err != nil {
+		return res.QuizExtensions, err
+	}
+
+	return res.QuizExtensions, nil
+}
+
+// quizStatisticsResponse is the envelope canvas wraps quiz statistics in
+type quizStatisticsResponse struct {
+	QuizStatistics []QuizStatistics `json:"quiz_statistics"`
+}
+
+// GetQuizStatistics returns the statistics of the latest submissions to the quiz.
+// When allVersions is set, every attempt is included
+func (c *CanvasClient) GetQuizStatistics(courseID int64, quizID int64, allVersions bool) (*QuizStatistics, error) {
+	res := quizStatisticsResponse{}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/courses/%d/quizzes/%d/statistics", c.ClientURL(), courseID, quizID))
+
+	if err != nil {
+		return &QuizStatistics{}, err
+	}
+
+	q := parsedURL.Query()
+
+	q.Add("all_versions", strconv.FormatBool(allVersions))
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getJSON(parsedURL.String(), &res)
+
+	if err != nil {
+		return &QuizStatistics{}, err
+	}
+
+	if len(res.QuizStatistics) == 0 {
+		return &QuizStatistics{}, nil
+	}
+
+	return &res.QuizStatistics[0], nil
+}
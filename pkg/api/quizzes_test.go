@@ -0,0 +1,159 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_GetQuizzes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/quizzes").
+		MatchParam("search_term", "midterm").
+		Reply(200).
+		JSON([]Quiz{{ID: 3, Title: "Midterm", PointsPossible: 42.5, QuizType: "assignment"}})
+
+	got, err := client.GetQuizzes(5, "midterm")
+	assert.Nil(t, err)
+	assert.Equal(t, []Quiz{{ID: 3, Title: "Midterm", PointsPossible: 42.5, QuizType: "assignment"}}, got)
+}
+
+func TestCanvasClient_CreateQuizQuestion(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/quizzes/3/questions").
+		AddMatcher(matchForm(url.Values{
+			"question[question_name]":             {"Sum"},
+			"question[question_text]":             {"2 + 2"},
+			"question[question_type]":             {"multiple_choice_question"},
+			"question[points_possible]":           {"1"},
+			"question[answers][0][answer_weight]": {"0"},
+			"question[answers][0][answer_text]":   {"3"},
+			"question[answers][1][answer_weight]": {"100"},
+			"question[answers][1][answer_text]":   {"4"},
+		})).
+		Reply(200).
+		JSON(QuizQuestion{ID: 11, QuizID: 3, QuestionType: MultipleChoiceQuestion})
+
+	got, err := client.CreateQuizQuestion(5, 3, &QuizQuestion{
+		QuestionName:   "Sum",
+		QuestionText:   "2 + 2",
+		QuestionType:   MultipleChoiceQuestion,
+		PointsPossible: 1,
+		Answers: []QuizAnswer{
+			{AnswerText: "3", AnswerWeight: 0},
+			{AnswerText: "4", AnswerWeight: 100},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), got.ID)
+}
+
+func TestCanvasClient_UpdateQuizQuestion(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/quizzes/3/questions/11").
+		AddMatcher(matchForm(url.Values{"question[question_text]": {"3 + 1"}})).
+		Reply(200).
+		JSON(QuizQuestion{ID: 11, QuizID: 3, QuestionName: "Sum", QuestionText: "3 + 1"})
+
+	got, err := client.UpdateQuizQuestion(5, 3, &QuizQuestion{ID: 11, QuestionText: "3 + 1"}, "question_text")
+	assert.Nil(t, err)
+	assert.Equal(t, "Sum", got.QuestionName)
+
+	_, err = client.UpdateQuizQuestion(5, 3, &QuizQuestion{ID: 11}, "question_txt")
+	assert.EqualError(t, err, `unknown fields to update: "question_txt"`)
+}
+
+func Test_quizQuestionForm(t *testing.T) {
+	form, err := quizQuestionForm(&QuizQuestion{
+		QuestionType: NumericalQuestion,
+		Answers: []QuizAnswer{
+			{NumericalAnswerType: "exact_answer", Exact: 3.14, Margin: 0.01, AnswerWeight: 100},
+			{NumericalAnswerType: "range_answer", Start: 1, End: 2, AnswerWeight: 100},
+		},
+	}, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "3.14", form.Get("question[answers][0][exact]"))
+	assert.Equal(t, "0.01", form.Get("question[answers][0][margin]"))
+	assert.Equal(t, "range_answer", form.Get("question[answers][1][numerical_answer_type]"))
+	assert.Equal(t, "2", form.Get("question[answers][1][end]"))
+	assert.Equal(t, "", form.Get("question[answers][1][exact]"))
+}
+
+func TestCanvasClient_SetQuizExtensions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/quizzes/3/extensions").
+		AddMatcher(matchForm(url.Values{
+			"quiz_extensions[0][user_id]":           {"8"},
+			"quiz_extensions[0][extra_attempts]":    {"1"},
+			"quiz_extensions[0][extra_time]":        {"30"},
+			"quiz_extensions[0][manually_unlocked]": {"false"},
+			"quiz_extensions[1][user_id]":           {"9"},
+			"quiz_extensions[1][extra_attempts]":    {"0"},
+			"quiz_extensions[1][extra_time]":        {"0"},
+			"quiz_extensions[1][manually_unlocked]": {"true"},
+			"quiz_extensions[1][extend_from_now]":   {"60"},
+		})).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"quiz_extensions": []QuizExtension{{QuizID: 3, UserID: 8, ExtraTime: 30, ExtraAttempts: 1}, {QuizID: 3, UserID: 9, ManuallyUnlocked: true}},
+		})
+
+	got, err := client.SetQuizExtensions(5, 3, []QuizExtension{
+		{UserID: 8, ExtraTime: 30, ExtraAttempts: 1},
+		{UserID: 9, ManuallyUnlocked: true, ExtendFromNow: 60},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, QuizExtension{QuizID: 3, UserID: 8, ExtraTime: 30, ExtraAttempts: 1}, got[0])
+}
+
+func TestCanvasClient_UpdateQuiz(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Put("/api/v1/courses/5/quizzes/3").
+		AddMatcher(matchForm(url.Values{"quiz[due_at]": {"2021-03-02T05:59:59Z"}})).
+		Reply(200).
+		JSON(Quiz{ID: 3, Title: "Midterm", DueAt: "2021-03-02T05:59:59Z", Published: true})
+
+	got, err := client.UpdateQuiz(5, &Quiz{ID: 3, DueAt: "2021-03-02T05:59:59Z"}, "due_at")
+	assert.Nil(t, err)
+	assert.True(t, got.Published)
+}
+
+func TestCanvasClient_GetQuizStatistics(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/quizzes/3/statistics").
+		MatchParam("all_versions", "true").
+		Reply(200).
+		BodyString(`{"quiz_statistics": [{
+			"id": "1",
+			"multiple_attempts_exist": true,
+			"question_statistics": [{
+				"id": "11", "question_type": "multiple_choice_question", "responses": 2,
+				"answers": [{"id": "3866", "text": "4", "correct": true, "responses": 2, "user_ids": [8, 9]}]
+			}],
+			"submission_statistics": {"score_average": 0.5, "scores": {"50": 2}}
+		}]}`)
+
+	got, err := client.GetQuizStatistics(5, 3, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", got.ID.String())
+	assert.Equal(t, "11", got.QuestionStatistics[0].ID.String())
+	assert.Equal(t, []int64{8, 9}, got.QuestionStatistics[0].Answers[0].UserIDs)
+	assert.Equal(t, int64(2), got.SubmissionStatistics.Scores["50"])
+}
@@ -0,0 +1,343 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Rubric is a rubric of a course or an account
+type Rubric struct {
+	ID                        int64             `json:"id"`
+	Title                     string            `json:"title"`
+	ContextID                 int64             `json:"context_id"`
+	ContextType               string            `json:"context_type"`
+	PointsPossible            float64           `json:"points_possible"`
+	Reusable                  bool              `json:"reusable"`
+	ReadOnly                  bool              `json:"read_only"`
+	FreeFormCriterionComments bool              `json:"free_form_criterion_comments"`
+	HideScoreTotal            bool              `json:"hide_score_total"`
+	Criteria                  []RubricCriterion `json:"data"`
+	// Assessments and Associations are only set when they are included
+	Assessments  []RubricAssessment  `json:"assessments"`
+	Associations []RubricAssociation `json:"associations"`
+}
+
+// RubricCriterion is a criterion of a rubric
+type RubricCriterion struct {
+	ID                string         `json:"id"`
+	Description       string         `json:"description"`
+	LongDescription   string         `json:"long_description"`
+	Points            float64        `json:"points"`
+	CriterionUseRange bool           `json:"criterion_use_range"`
+	IgnoreForScoring  bool           `json:"ignore_for_scoring"`
+	Ratings           []RubricRating `json:"ratings"`
+}
+
+// RubricRating is a rating of a rubric criterion
+type RubricRating struct {
+	ID              string  `json:"id"`
+	CriterionID     string  `json:"criterion_id"`
+	Description     string  `json:"description"`
+	LongDescription string  `json:"long_description"`
+	Points          float64 `json:"points"`
+}
+
+// RubricSettings are the settings of the rubric of an assignment
+type RubricSettings struct {
+	ID                        int64   `json:"id"`
+	Title                     string  `json:"title"`
+	PointsPossible            float64 `json:"points_possible"`
+	FreeFormCriterionComments bool    `json:"free_form_criterion_comments"`
+	HideScoreTotal            bool    `json:"hide_score_total"`
+	HidePoints                bool    `json:"hide_points"`
+}
+
+// RubricAssociation links a rubric to an assignment, a course or an account
+type RubricAssociation struct {
+	ID            int64 `json:"id"`
+	RubricID      int64 `json:"rubric_id"`
+	AssociationID int64 `json:"association_id"`
+	// AssociationType is one of "Assignment", "Course" or "Account"
+	AssociationType string `json:"association_type"`
+	Title           string `json:"title"`
+	UseForGrading   bool   `json:"use_for_grading"`
+	HideScoreTotal  bool   `json:"hide_score_total"`
+	// Purpose is "grading" for rubrics used to grade and "bookmark" for rubrics kept for reuse
+	Purpose    string `json:"purpose"`
+	Bookmarked bool   `json:"bookmarked"`
+}
+
+// RubricAssessment is the assessment of a submission with a rubric
+type RubricAssessment struct {
+	ID                  int64   `json:"id"`
+	RubricID            int64   `json:"rubric_id"`
+	RubricAssociationID int64   `json:"rubric_association_id"`
+	Score               float64 `json:"score"`
+	ArtifactType        string  `json:"artifact_type"`
+	ArtifactID          int64   `json:"artifact_id"`
+	ArtifactAttempt     int64   `json:"artifact_attempt"`
+	// AssessmentType is one of "grading", "peer_review" or "provisional_grade"
+	AssessmentType string `json:"assessment_type"`
+	AssessorID     int64  `json:"assessor_id"`
+	// UserID is the user whose submission is assessed, it is only sent by the client
+	UserID int64                       `json:"-"`
+	Data   []RubricCriterionAssessment `json:"data"`
+}
+
+// RubricCriterionAssessment is the assessment of a single criterion
+type RubricCriterionAssessment struct {
+	CriterionID string  `json:"criterion_id"`
+	Points      float64 `json:"points"`
+	RatingID    string  `json:"rating_id"`
+	Comments    string  `json:"comments"`
+	Description string  `json:"description"`
+}
+
+// rubricResponse is the envelope canvas wraps created and updated rubrics in
+type rubricResponse struct {
+	Rubric            Rubric             `json:"rubric"`
+	RubricAssociation *RubricAssociation `json:"rubric_association"`
+}
+
+// GetRubrics returns the rubrics of the context, such as "course_123" or "account_1"
+func (c *CanvasClient) GetRubrics(contextCode string) ([]Rubric, error) {
+	r := make([]Rubric, 0)
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return r, err
+	}
+
+	err = c.getPaginatedJSON(contextURL+"/rubrics", &r)
+
+	if err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// GetRubric returns the rubric with the given rubricID.
+// Include can be any of: {"assessments" | "graded_assessments" | "peer_assessments" | "associations" | "assignment_associations" | "course_associations" | "account_associations"}
+func (c *CanvasClient) GetRubric(contextCode string, rubricID int64, include ...string) (*Rubric, error) {
+	r := Rubric{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &r, err
+	}
+
+	q := url.Values{}
+	for _, i := range include {
+		q.Add("include[]", i)
+	}
+	if len(include) != 0 {
+		q.Add("style", "full")
+	}
+
+	requestURL := fmt.Sprintf("%s/rubrics/%d?%s", contextURL, rubricID, q.Encode())
+	err = c.getJSON(requestURL, &r)
+
+	if err != nil {
+		return &r, err
+	}
+
+	return &r, nil
+}
+
+// CreateRubric creates a rubric in the context and associates it as given.
+// A nil association leaves the rubric associated with the context only
+func (c *CanvasClient) CreateRubric(contextCode string, rubric *Rubric, association *RubricAssociation) (*Rubric, error) {
+	return c.sendRubric("POST", contextCode, "/rubrics", rubric, association)
+}
+
+// UpdateRubric updates the rubric to match the given one
+func (c *CanvasClient) UpdateRubric(contextCode string, rubric *Rubric, association *RubricAssociation) (*Rubric, error) {
+	return c.sendRubric("PUT", contextCode, fmt.Sprintf("/rubrics/%d", rubric.ID), rubric, association)
+}
+
+func (c *CanvasClient) sendRubric(method string, contextCode string, path string, rubric *Rubric, association *RubricAssociation) (*Rubric, error) {
+	res := rubricResponse{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &res.Rubric, err
+	}
+
+	form := rubricForm(rubric)
+	if association != nil {
+		addRubricAssociationForm(form, association)
+	}
+
+	err = c.sendJSON(method, contextURL+path, form, &res)
+
+	if err != nil {
+		return &res.Rubric, err
+	}
+
+	if res.RubricAssociation != nil {
+		res.Rubric.Associations = append(res.Rubric.Associations, *res.RubricAssociation)
+	}
+
+	return &res.Rubric, nil
+}
+
+// DeleteRubric deletes the rubric with the given rubricID and returns it
+func (c *CanvasClient) DeleteRubric(contextCode string, rubricID int64) (*Rubric, error) {
+	deleted := Rubric{}
+
+	contextURL, err := c.contextURL(contextCode)
+
+	if err != nil {
+		return &deleted, err
+	}
+
+	err = c.sendJSON("DELETE", fmt.Sprintf("%s/rubrics/%d", contextURL, rubricID), nil, &deleted)
+
+	if err != nil {
+		return &deleted, err
+	}
+
+	return &deleted, nil
+}
+
+func rubricForm(rubric *Rubric) url.Values {
+	form := url.Values{}
+	form.Add("rubric[title]", rubric.Title)
+	form.Add("rubric[free_form_criterion_comments]", strconv.FormatBool(rubric.FreeFormCriterionComments))
+	form.Add("rubric[hide_score_total]", strconv.FormatBool(rubric.HideScoreTotal))
+	for i, criterion := range rubric.Criteria {
+		key := fmt.Sprintf("rubric[criteria][%d]", i)
+		if criterion.ID != "" {
+			form.Add(key+"[id]", criterion.ID)
+		}
+		form.Add(key+"[description]", criterion.Description)
+		form.Add(key+"[long_description]", criterion.LongDescription)
+		form.Add(key+"[points]", strconv.FormatFloat(criterion.Points, 'f', -1, 64))
+		form.Add(key+"[criterion_use_range]", strconv.FormatBool(criterion.CriterionUseRange))
+		for j, rating := range criterion.Ratings {
+			ratingKey := fmt.Sprintf("%s[ratings][%d]", key, j)
+			if rating.ID != "" {
+				form.Add(ratingKey+"[id]", rating.ID)
+			}
+			form.Add(ratingKey+"[description]", rating.Description)
+			form.Add(ratingKey+"[long_description]", rating.LongDescription)
+			form.Add(ratingKey+"[points]", strconv.FormatFloat(rating.Points, 'f', -1, 64))
+		}
+	}
+
+	return form
+}
+
+func addRubricAssociationForm(form url.Values, association *RubricAssociation) {
+	if association.RubricID != 0 {
+		form.Add("rubric_association[rubric_id]", strconv.FormatInt(association.RubricID, 10))
+	}
+	form.Add("rubric_association[association_id]", strconv.FormatInt(association.AssociationID, 10))
+	form.Add("rubric_association[association_type]", association.AssociationType)
+	if association.Title != "" {
+		form.Add("rubric_association[title]", association.Title)
+	}
+	form.Add("rubric_association[use_for_grading]", strconv.FormatBool(association.UseForGrading))
+	form.Add("rubric_association[hide_score_total]", strconv.FormatBool(association.HideScoreTotal))
+	if association.Purpose != "" {
+		form.Add("rubric_association[purpose]", association.Purpose)
+	}
+	form.Add("rubric_association[bookmarked]", strconv.FormatBool(association.Bookmarked))
+}
+
+// CreateRubricAssociation associates an existing rubric with an assignment or a context of the course
+func (c *CanvasClient) CreateRubricAssociation(courseID int64, association *RubricAssociation) (*RubricAssociation, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations", c.ClientURL(), courseID)
+
+	return c.sendRubricAssociation("POST", requestURL, association)
+}
+
+// UpdateRubricAssociation updates the association to match the given one
+func (c *CanvasClient) UpdateRubricAssociation(courseID int64, association *RubricAssociation) (*RubricAssociation, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations/%d", c.ClientURL(), courseID, association.ID)
+
+	return c.sendRubricAssociation("PUT", requestURL, association)
+}
+
+// DeleteRubricAssociation removes the association with the given associationID and returns it
+func (c *CanvasClient) DeleteRubricAssociation(courseID int64, associationID int64) (*RubricAssociation, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations/%d", c.ClientURL(), courseID, associationID)
+
+	return c.sendRubricAssociation("DELETE", requestURL, nil)
+}
+
+func (c *CanvasClient) sendRubricAssociation(method string, requestURL string, association *RubricAssociation) (*RubricAssociation, error) {
+	res := struct {
+		RubricAssociation RubricAssociation `json:"rubric_association"`
+	}{}
+
+	var form url.Values
+	if association != nil {
+		form = url.Values{}
+		addRubricAssociationForm(form, association)
+	}
+
+	err := c.sendJSON(method, requestURL, form, &res)
+
+	if err != nil {
+		return &res.RubricAssociation, err
+	}
+
+	return &res.RubricAssociation, nil
+}
+
+// CreateRubricAssessment assesses the submission of the assessments UserID with the rubric of the association
+func (c *CanvasClient) CreateRubricAssessment(courseID int64, associationID int64, assessment *RubricAssessment) (*RubricAssessment, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations/%d/rubric_assessments", c.ClientURL(), courseID, associationID)
+
+	return c.sendRubricAssessment("POST", requestURL, rubricAssessmentForm(assessment))
+}
+
+// UpdateRubricAssessment updates the assessment to match the given one
+func (c *CanvasClient) UpdateRubricAssessment(courseID int64, associationID int64, assessment *RubricAssessment) (*RubricAssessment, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations/%d/rubric_assessments/%d", c.ClientURL(), courseID, associationID, assessment.ID)
+
+	return c.sendRubricAssessment("PUT", requestURL, rubricAssessmentForm(assessment))
+}
+
+// DeleteRubricAssessment deletes the assessment with the given assessmentID and returns it
+func (c *CanvasClient) DeleteRubricAssessment(courseID int64, associationID int64, assessmentID int64) (*RubricAssessment, error) {
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/rubric_associations/%d/rubric_assessments/%d", c.ClientURL(), courseID, associationID, assessmentID)
+
+	return c.sendRubricAssessment("DELETE", requestURL, nil)
+}
+
+func (c *CanvasClient) sendRubricAssessment(method string, requestURL string, form url.Values) (*RubricAssessment, error) {
+	a := RubricAssessment{}
+
+	err := c.sendJSON(method, requestURL, form, &a)
+
+	if err != nil {
+		return &a, err
+	}
+
+	return &a, nil
+}
+
+func rubricAssessmentForm(assessment *RubricAssessment) url.Values {
+	form := url.Values{}
+	form.Add("rubric_assessment[user_id]", strconv.FormatInt(assessment.UserID, 10))
+	form.Add("rubric_assessment[assessment_type]", assessment.AssessmentType)
+	for _, criterion := range assessment.Data {
+		key := fmt.Sprintf("rubric_assessment[criterion_%s]", criterion.CriterionID)
+		form.Add(key+"[points]", strconv.FormatFloat(criterion.Points, 'f', -1, 64))
+		if criterion.RatingID != "" {
+			form.Add(key+"[rating_id]", criterion.RatingID)
+		}
+		if criterion.Comments != "" {
+			form.Add(key+"[comments]", criterion.Comments)
+		}
+	}
+
+	return form
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCanvasClient_CreateRubric(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/rubrics").
+		AddMatcher(matchForm(url.Values{
+			"rubric[title]":                                     {"Essay"},
+			"rubric[free_form_criterion_comments]":              {"false"},
+			"rubric[hide_score_total]":                          {"false"},
+			"rubric[criteria][0][description]":                  {"Thesis"},
+			"rubric[criteria][0][long_description]":             {""},
+			"rubric[criteria][0][points]":                       {"5"},
+			"rubric[criteria][0][criterion_use_range]":          {"false"},
+			"rubric[criteria][0][ratings][0][description]":      {"Clear"},
+			"rubric[criteria][0][ratings][0][long_description]": {""},
+			"rubric[criteria][0][ratings][0][points]":           {"5"},
+			"rubric[criteria][0][ratings][1][description]":      {"Missing"},
+			"rubric[criteria][0][ratings][1][long_description]": {""},
+			"rubric[criteria][0][ratings][1][points]":           {"0"},
+			"rubric_association[association_id]":                {"40"},
+			"rubric_association[association_type]":              {"Assignment"},
+			"rubric_association[use_for_grading]":               {"true"},
+			"rubric_association[hide_score_total]":              {"false"},
+			"rubric_association[purpose]":                       {"grading"},
+			"rubric_association[bookmarked]":                    {"false"},
+		})).
+		Reply(200).
+		BodyString(`{"rubric": {"id": 3, "title": "Essay", "points_possible": 5,
+			"data": [{"id": "_1", "description": "Thesis", "points": 5, "ratings": [
+				{"id": "r1", "description": "Clear", "points": 5}, {"id": "r2", "description": "Missing", "points": 0}]}]},
+			"rubric_association": {"id": 9, "rubric_id": 3, "association_id": 40, "association_type": "Assignment", "use_for_grading": true}}`)
+
+	got, err := client.CreateRubric("course_5", &Rubric{
+		Title: "Essay",
+		Criteria: []RubricCriterion{{
+			Description: "Thesis",
+			Points:      5,
+			Ratings:     []RubricRating{{Description: "Clear", Points: 5}, {Description: "Missing", Points: 0}},
+		}},
+	}, &RubricAssociation{AssociationID: 40, AssociationType: "Assignment", UseForGrading: true, Purpose: "grading"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "_1", got.Criteria[0].ID)
+	assert.Equal(t, "r2", got.Criteria[0].Ratings[1].ID)
+	assert.Equal(t, int64(9), got.Associations[0].ID)
+}
+
+func TestCanvasClient_GetRubric(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/accounts/1/rubrics/3").
+		MatchParam("include[]", "assessments").
+		MatchParam("style", "full").
+		Reply(200).
+		BodyString(`{"id": 3, "context_type": "Account",
+			"assessments": [{"id": 4, "score": 5, "assessment_type": "grading", "data": [{"criterion_id": "_1", "points": 5, "rating_id": "r1"}]}]}`)
+
+	got, err := client.GetRubric("account_1", 3, "assessments")
+	assert.Nil(t, err)
+	assert.Equal(t, "Account", got.ContextType)
+	assert.Equal(t, "r1", got.Assessments[0].Data[0].RatingID)
+}
+
+func TestCanvasClient_CreateRubricAssessment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Post("/api/v1/courses/5/rubric_associations/9/rubric_assessments").
+		AddMatcher(matchForm(url.Values{
+			"rubric_assessment[user_id]":                {"8"},
+			"rubric_assessment[assessment_type]":        {"peer_review"},
+			"rubric_assessment[criterion__1][points]":   {"4.5"},
+			"rubric_assessment[criterion__1][comments]": {"Strong"},
+		})).
+		Reply(200).
+		JSON(RubricAssessment{ID: 4, Score: 4.5, AssessmentType: "peer_review", AssessorID: 9})
+
+	got, err := client.CreateRubricAssessment(5, 9, &RubricAssessment{
+		UserID:         8,
+		AssessmentType: "peer_review",
+		Data:           []RubricCriterionAssessment{{CriterionID: "_1", Points: 4.5, Comments: "Strong"}},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4.5, got.Score)
+}
+
+func TestAssignment_Rubric(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/todo").
+		Reply(200).
+		BodyString(`[{"type": "grading", "assignment": {"id": 40,
+			"rubric": [{"id": "_1", "points": 5, "ratings": [{"id": "r1", "points": 5}]}],
+			"rubric_settings": {"id": 3, "title": "Essay", "points_possible": 5, "hide_points": true}}}]`)
+
+	got, err := client.GetTodo()
+	assert.Nil(t, err)
+	assert.Equal(t, "_1", (*got)[0].Assignment.Rubric[0].ID)
+	assert.True(t, (*got)[0].Assignment.RubricSettings.HidePoints)
+}
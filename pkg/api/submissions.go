@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Submission is the submission of a student to an assignment
+type Submission struct {
+	ID           int64 `json:"id"`
+	AssignmentID int64 `json:"assignment_id"`
+	// UserID is not set for submissions looked up by their AnonymousID
+	UserID         int64   `json:"user_id"`
+	AnonymousID    string  `json:"anonymous_id"`
+	Attempt        int64   `json:"attempt"`
+	Body           string  `json:"body"`
+	URL            string  `json:"url"`
+	PreviewURL     string  `json:"preview_url"`
+	SubmissionType string  `json:"submission_type"`
+	SubmittedAt    string  `json:"submitted_at"`
+	Grade          string  `json:"grade"`
+	Score          float64 `json:"score"`
+	EnteredGrade   string  `json:"entered_grade"`
+	EnteredScore   float64 `json:"entered_score"`
+	GraderID       int64   `json:"grader_id"`
+	GradedAt       string  `json:"graded_at"`
+	// PostedAt is empty while the grade is hidden from the student
+	PostedAt                      string `json:"posted_at"`
+	GradeMatchesCurrentSubmission bool   `json:"grade_matches_current_submission"`
+	// WorkflowState is one of "submitted", "unsubmitted", "graded" or "pending_review"
+	WorkflowState string `json:"workflow_state"`
+	Late          bool   `json:"late"`
+	Missing       bool   `json:"missing"`
+	Excused       bool   `json:"excused"`
+	// LatePolicyStatus is one of "late", "missing", "extended" or "none"
+	LatePolicyStatus   string              `json:"late_policy_status"`
+	PointsDeducted     float64             `json:"points_deducted"`
+	SecondsLate        int64               `json:"seconds_late"`
+	Attachments        []File              `json:"attachments"`
+	SubmissionComments []SubmissionComment `json:"submission_comments"`
+}
+
+// GetAnonymousSubmission returns the submission to the assignment with the given anonymousID,
+// which is how graders of anonymously graded assignments refer to students
+func (c *CanvasClient) GetAnonymousSubmission(courseID int64, assignmentID int64, anonymousID string) (*Submission, error) {
+	s := Submission{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/courses/%d/assignments/%d/anonymous_submissions/%s",
+		c.ClientURL(), courseID, assignmentID, url.PathEscape(anonymousID))
+	err := c.getJSON(requestURL, &s)
+
+	if err != nil {
+		return &s, err
+	}
+
+	return &s, nil
+}
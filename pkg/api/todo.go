@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// TodoItem is an assignment or quiz on a users todo list
+type TodoItem struct {
+	// Type is "grading" for items that need grading and "submitting" for items that need submitting
+	Type              string      `json:"type"`
+	Assignment        *Assignment `json:"assignment"`
+	Quiz              *Quiz       `json:"quiz"`
+	Ignore            string      `json:"ignore"`
+	IgnorePermanently string      `json:"ignore_permanently"`
+	NeedsGradingCount int64       `json:"needs_grading_count"`
+	ContextType       string      `json:"context_type"`
+	CourseID          int64       `json:"course_id"`
+	GroupID           int64       `json:"group_id"`
+	HTMLURL           string      `json:"html_url"`
+}
+
+// TodoItemCount is the number of items on a users todo list
+type TodoItemCount struct {
+	NeedsGradingCount            int64 `json:"needs_grading_count"`
+	AssignmentsNeedingSubmitting int64 `json:"assignments_needing_submitting"`
+}
+
+// TodoOptions is an interface for the lookup of todo items
+type TodoOptions struct {
+	ungradedQuizzes bool
+}
+
+// TodoOption is an adapter for generating options
+type TodoOption func(*TodoOptions)
+
+// WithUngradedQuizzes includes ungraded quizzes on the todo list
+func WithUngradedQuizzes() TodoOption {
+	return func(to *TodoOptions) {
+		to.ungradedQuizzes = true
+	}
+}
+
+// GetTodo returns todo list
+func (c *CanvasClient) GetTodo(setters ...TodoOption) (*[]TodoItem, error) {
+	return c.getTodo(fmt.Sprintf("%s/api/v1/users/self/todo", c.ClientURL()), setters)
+}
+
+// GetCourseTodo returns todo list of a course
+func (c *CanvasClient) GetCourseTodo(courseID int64, setters ...TodoOption) (*[]TodoItem, error) {
+	return c.getTodo(fmt.Sprintf("%s/api/v1/courses/%d/todo", c.ClientURL(), courseID), setters)
+}
+
+func (c *CanvasClient) getTodo(requestURL string, setters []TodoOption) (*[]TodoItem, error) {
+	args := &TodoOptions{
+		ungradedQuizzes: false,
+	}
+	t := make([]TodoItem, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	parsedURL, err := url.Parse(requestURL)
+
+	if err != nil {
+		return &t, err
+	}
+
+	q := parsedURL.Query()
+
+	if args.ungradedQuizzes {
+		q.Add("include[]", "ungraded_quizzes")
+	}
+
+	parsedURL.RawQuery = q.Encode()
+
+	err = c.getJSON(parsedURL.String(), &t)
+
+	if err != nil {
+		return &t, err
+	}
+
+	return &t, nil
+}
+
+// GetTodoItemCount returns the number of items on the todo list
+func (c *CanvasClient) GetTodoItemCount() (*TodoItemCount, error) {
+	count := TodoItemCount{}
+
+	requestURL := fmt.Sprintf("%s/api/v1/users/self/todo_item_count", c.ClientURL())
+	err := c.getJSON(requestURL, &count)
+
+	if err != nil {
+		return &count, err
+	}
+
+	return &count, nil
+}
+
+// IgnoreTodoItem removes the item from the todo list.
+// A permanently ignored item is not shown again even if it needs attention later
+func (c *CanvasClient) IgnoreTodoItem(item TodoItem, permanent bool) error {
+	requestURL := item.Ignore
+	if permanent {
+		requestURL = item.IgnorePermanently
+	}
+
+	if requestURL == "" {
+		return errors.New("todo item has no ignore url")
+	}
+
+	return c.sendJSON("DELETE", requestURL, nil, nil)
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const todoJSON = `[
+	{
+		"type": "grading",
+		"assignment": {"id": 20, "name": "Essay", "points_possible": 12.5},
+		"ignore": "https://domain.instructure.com/api/v1/users/self/todo/assignment_20/grading?permanent=0",
+		"ignore_permanently": "https://domain.instructure.com/api/v1/users/self/todo/assignment_20/grading?permanent=1",
+		"html_url": "https://domain.instructure.com/courses/5/gradebook/speed_grader?assignment_id=20",
+		"needs_grading_count": 3,
+		"context_type": "Course",
+		"course_id": 5,
+		"group_id": null
+	}
+]`
+
+func TestCanvasClient_GetTodo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/courses/5/todo").
+		MatchParam("include[]", "ungraded_quizzes").
+		Reply(200).
+		BodyString(todoJSON)
+
+	got, err := client.GetCourseTodo(5, WithUngradedQuizzes())
+	assert.Nil(t, err)
+
+	items := *got
+	assert.Len(t, items, 1)
+	assert.Equal(t, "grading", items[0].Type)
+	assert.Equal(t, 12.5, items[0].Assignment.PointsPossible)
+	assert.Equal(t, int64(3), items[0].NeedsGradingCount)
+	assert.Equal(t, int64(5), items[0].CourseID)
+}
+
+func TestCanvasClient_IgnoreTodoItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Delete("/api/v1/users/self/todo/assignment_20/grading").
+		MatchParam("permanent", "1").
+		Reply(204)
+
+	item := TodoItem{
+		Ignore:            "https://domain.instructure.com/api/v1/users/self/todo/assignment_20/grading?permanent=0",
+		IgnorePermanently: "https://domain.instructure.com/api/v1/users/self/todo/assignment_20/grading?permanent=1",
+	}
+
+	err := client.IgnoreTodoItem(item, true)
+	assert.Nil(t, err)
+	assert.True(t, gock.IsDone())
+
+	err = client.IgnoreTodoItem(TodoItem{}, false)
+	assert.Error(t, err)
+}
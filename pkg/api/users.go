@@ -28,6 +28,14 @@ type User struct {
 	Locale       string            `json:"locale"`
 }
 
+// UserDisplay is the short form of a user canvas shows next to content they authored
+type UserDisplay struct {
+	ID             int64  `json:"id"`
+	DisplayName    string `json:"display_name"`
+	AvatarImageURL string `json:"avatar_image_url"`
+	HTMLURL        string `json:"html_url"`
+}
+
 // AccountUsersOptions is an interface for the lookup of account users
 type AccountUsersOptions struct {
 	searchTerm     string
@@ -40,138 +48,6 @@ type AccountUsersOptions struct {
 // AccountUsersOption is an adapter for generating options
 type AccountUsersOption func(*AccountUsersOptions)
 
-// ActivityStreamOptions is an interface for the lookup of Activity Stream
-type ActivityStreamOptions struct {
-	onlyActiveCourses bool
-}
-
-// ActivityStream is Users activity feed
-type ActivityStream struct {
-	DiscussionTopics  []DiscussionTopic
-	Announcements     []Announcement
-	Conversations     []Conversation
-	Messages          []Message
-	Conferences       []Conference
-	Collaborations    []Collaboration
-	AssesmentRequests []AssesmentRequest
-}
-
-// ActivityStreamOption is an adapter for generating options
-type ActivityStreamOption func(*ActivityStreamOptions)
-
-type activityStreamPlaceholder []activityStreamPlaceholderItem
-type activityStreamPlaceholderItem map[string]interface{}
-
-// Message is a ActivityStream message
-type Message struct {
-	ID                   int64
-	NotificationCategory string
-
-	CreatedAt string
-	UpdatedAt string
-	Title     string
-	Message   string
-	ReadState bool
-	CourseID  int64
-	GroupID   int64
-	HTMLURL   string
-}
-
-// DiscussionTopic is a ActivityStream discussion
-type DiscussionTopic struct {
-	ID                         int64
-	TotalRootDiscussionEntries int64
-	RequireInitialPost         bool
-
-	CreatedAt string
-	UpdatedAt string
-	Title     string
-	Message   string
-	ReadState bool
-	CourseID  int64
-	GroupID   int64
-	HTMLURL   string
-
-	UserHasPosted         interface{}
-	RootDiscussionEntries interface{}
-}
-
-// Announcement is a ActivityStream announcement
-type Announcement struct {
-	ID                         int64
-	TotalRootDiscussionEntries int64
-	ContextType                string
-	RequireInitialPost         bool
-	CreatedAt                  string
-	UpdatedAt                  string
-	Title                      string
-	Message                    string
-	ReadState                  bool
-	CourseID                   int64
-	GroupID                    int64
-	HTMLURL                    string
-	UserHasPosted              interface{}
-	RootDiscussionEntries      interface{}
-}
-
-// Conversation is an ActivityStream conversation
-type Conversation struct {
-	ID               int64
-	Private          bool
-	ParticipantCount int64
-
-	CreatedAt      string
-	UpdatedAt      string
-	Title          string
-	LatestMessages interface{}
-	ReadState      bool
-	CourseID       int64
-	GroupID        int64
-	HTMLURL        string
-}
-
-//Conference is an ActivityStream conference
-type Conference struct {
-	ID int64
-
-	CreatedAt string
-	UpdatedAt string
-	Title     string
-	Message   string
-	ReadState bool
-	CourseID  int64
-	GroupID   int64
-	HTMLURL   string
-}
-
-// Collaboration is an ActivityStream collaboration
-type Collaboration struct {
-	ID int64
-
-	CreatedAt string
-	UpdatedAt string
-	Title     string
-	Message   string
-	ReadState bool
-	CourseID  int64
-	GroupID   int64
-	HTMLURL   string
-}
-
-// AssesmentRequest is an ActivityStream assessment request
-type AssesmentRequest struct {
-	ID int64
-
-	CreatedAt string
-	UpdatedAt string
-	Title     string
-	Message   string
-	ReadState bool
-	CourseID  int64
-	GroupID   int64
-	HTMLURL   string
-}
-
 // SearchTerm is a search term to search by
 func SearchTerm(searchTerm string) AccountUsersOption {
 	return func(auo *AccountUsersOptions) {
@@ -292,177 +168,103 @@ func (c *CanvasClient) GetDashboardPositions(userID int64) (*DashboardPositions,
 	return &d, nil
 }
 
-// WithOnlyActiveUsers returns only active users of the account
-func WithOnlyActiveUsers() ActivityStreamOption {
-	return func(aso *ActivityStreamOptions) {
-		aso.onlyActiveCourses = true
-	}
+// MissingSubmissionsOptions is an interface for the lookup of missing submissions
+type MissingSubmissionsOptions struct {
+	include []string
+	filter  []string
+	err     []error
+}
+
+// MissingSubmissionsOption is an adapter for generating options
+type MissingSubmissionsOption func(*MissingSubmissionsOptions)
 
+// WithMissingSubmissionsInclude adds related objects to the assignments
+// Include can only be one of: {"planner_overrides" | "course"}
+func WithMissingSubmissionsInclude(include ...string) MissingSubmissionsOption {
+	for _, i := range include {
+		if i != "planner_overrides" && i != "course" {
+			return func(mso *MissingSubmissionsOptions) {
+				mso.err = append(mso.err, errors.New("keyword include can be only one of: 'planner_overrides' | 'course'"))
+			}
+		}
+	}
+	return func(mso *MissingSubmissionsOptions) {
+		mso.include = append(mso.include, include...)
+	}
 }
 
-// GetActivityStream returns activity stream
-func (c *CanvasClient) GetActivityStream(setters ...ActivityStreamOption) (*ActivityStream, error) {
-	args := &ActivityStreamOptions{
-		onlyActiveCourses: false,
+// WithMissingSubmissionsFilter limits the assignments that are returned
+// Filter can only be one of: {"submittable" | "current_grading_period"}
+func WithMissingSubmissionsFilter(filter ...string) MissingSubmissionsOption {
+	for _, f := range filter {
+		if f != "submittable" && f != "current_grading_period" {
+			return func(mso *MissingSubmissionsOptions) {
+				mso.err = append(mso.err, errors.New("keyword filter can be only one of: 'submittable' | 'current_grading_period'"))
+			}
+		}
 	}
-	s := make(activityStreamPlaceholder, 0)
-	stream := ActivityStream{}
-	for _, setter := range setters {
-		setter(args)
+	return func(mso *MissingSubmissionsOptions) {
+		mso.filter = append(mso.filter, filter...)
 	}
+}
 
-	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/users/self/activity_stream", c.ClientURL()))
+// GetUpcomingEvents returns the upcoming calendar events and assignments of the user
+func (c *CanvasClient) GetUpcomingEvents() ([]CalendarEvent, error) {
+	e := make([]CalendarEvent, 0)
+
+	requestURL := fmt.Sprintf("%s/api/v1/users/self/upcoming_events", c.ClientURL())
+	err := c.getJSON(requestURL, &e)
 
 	if err != nil {
-		return &stream, err
+		return e, err
 	}
 
-	q := parsedURL.Query()
+	return e, nil
+}
 
-	q.Add("order", strconv.FormatBool(args.onlyActiveCourses))
+// GetMissingSubmissions returns the past-due assignments the user has not submitted
+func (c *CanvasClient) GetMissingSubmissions(setters ...MissingSubmissionsOption) ([]Assignment, error) {
+	return c.getMissingSubmissions("self", setters)
+}
 
-	parsedURL.RawQuery = q.Encode()
+// GetUserMissingSubmissions returns the past-due assignments an observed user has not submitted
+func (c *CanvasClient) GetUserMissingSubmissions(userID int64, setters ...MissingSubmissionsOption) ([]Assignment, error) {
+	return c.getMissingSubmissions(strconv.FormatInt(userID, 10), setters)
+}
 
-	err = c.getJSON(parsedURL.String(), &s)
+func (c *CanvasClient) getMissingSubmissions(userID string, setters []MissingSubmissionsOption) ([]Assignment, error) {
+	args := &MissingSubmissionsOptions{}
+	a := make([]Assignment, 0)
+	for _, setter := range setters {
+		setter(args)
+	}
+
+	if len(args.err) != 0 {
+		return a, args.err[0]
+	}
+
+	parsedURL, err := url.Parse(fmt.Sprintf("%s/api/v1/users/%s/missing_submissions", c.ClientURL(), userID))
 
 	if err != nil {
-		return &stream, err
+		return a, err
 	}
 
-	return activityStreamFromPlaceholder(&s), nil
-}
+	q := parsedURL.Query()
 
-func activityStreamFromPlaceholder(placeholder *activityStreamPlaceholder) *ActivityStream {
-	stream := ActivityStream{
-		Announcements:    make([]Announcement, 0),
-		DiscussionTopics: make([]DiscussionTopic, 0),
-		Conversations:    make([]Conversation, 0),
-		Messages:         make([]Message, 0),
-		Conferences:      make([]Conference, 0),
+	for _, include := range args.include {
+		q.Add("include[]", include)
 	}
-	for _, item := range *placeholder {
-		groupID, _ := item["group_id"].(float64)
-		if item["type"] == "Announcement" {
-			a := Announcement{
-				ID:                         int64(item["announcement_id"].(float64)),
-				TotalRootDiscussionEntries: int64(item["total_root_discussion_entries"].(float64)),
-				RequireInitialPost:         item["require_initial_post"].(bool),
-				UserHasPosted:              item["user_has_posted"],
-				RootDiscussionEntries:      item["root_discussion_entries"],
-				ContextType:                item["context_type"].(string),
-				CreatedAt:                  item["created_at"].(string),
-				UpdatedAt:                  item["updated_at"].(string),
-				Title:                      item["title"].(string),
-				Message:                    item["message"].(string),
-				ReadState:                  item["read_state"].(bool),
-				CourseID:                   int64(item["course_id"].(float64)),
-				GroupID:                    int64(groupID),
-				HTMLURL:                    item["html_url"].(string),
-			}
-			stream.Announcements = append(stream.Announcements, a)
-		} else if item["type"] == "DiscussionTopic" {
-			groupID, _ := item["group_id"].(float64)
-
-			d := DiscussionTopic{
-				ID:                         int64(item["discussion_topic_id"].(float64)),
-				TotalRootDiscussionEntries: int64(item["total_root_discussion_entries"].(float64)),
-				RequireInitialPost:         item["require_initial_post"].(bool),
-				CreatedAt:                  item["created_at"].(string),
-				UpdatedAt:                  item["updated_at"].(string),
-				Title:                      item["title"].(string),
-				Message:                    item["message"].(string),
-				ReadState:                  item["read_state"].(bool),
-				CourseID:                   int64(item["course_id"].(float64)),
-				GroupID:                    int64(groupID),
-				HTMLURL:                    item["html_url"].(string),
-			}
-			stream.DiscussionTopics = append(stream.DiscussionTopics, d)
-		} else if item["type"] == "Conversation" {
-			c := Conversation{
-				ID:               int64(item["id"].(float64)),
-				CreatedAt:        item["created_at"].(string),
-				UpdatedAt:        item["updated_at"].(string),
-				Title:            item["title"].(string),
-				LatestMessages:   item["latest_messages"],
-				ReadState:        item["read_state"].(bool),
-				CourseID:         int64(item["course_id"].(float64)),
-				GroupID:          int64(groupID),
-				HTMLURL:          item["html_url"].(string),
-				Private:          item["private"].(bool),
-				ParticipantCount: int64(item["participant_count"].(float64)),
-			}
-			stream.Conversations = append(stream.Conversations, c)
-		} else if item["type"] == "Message" {
-			m := Message{
-				ID:                   int64(item["id"].(float64)),
-				CreatedAt:            item["created_at"].(string),
-				UpdatedAt:            item["updated_at"].(string),
-				Title:                item["title"].(string),
-				Message:              item["message"].(string),
-				ReadState:            item["read_state"].(bool),
-				CourseID:             int64(item["course_id"].(float64)),
-				GroupID:              int64(groupID),
-				HTMLURL:              item["html_url"].(string),
-				NotificationCategory: item["notification_category"].(string),
-			}
-			stream.Messages = append(stream.Messages, m)
-		} else if item["type"] == "Conference" {
-			c := Conference{
-				ID:        int64(item["conference_id"].(float64)),
-				CreatedAt: item["created_at"].(string),
-				UpdatedAt: item["updated_at"].(string),
-				Title:     item["title"].(string),
-				Message:   item["message"].(string),
-				ReadState: item["read_state"].(bool),
-				CourseID:  int64(item["course_id"].(float64)),
-				GroupID:   int64(groupID),
-				HTMLURL:   item["html_url"].(string),
-			}
-			stream.Conferences = append(stream.Conferences, c)
-		} else if item["type"] == "Submission" {
-
-		} else if item["type"] == "Collaboration" {
-			c := Collaboration{
-				ID:        int64(item["conference_id"].(float64)),
-				CreatedAt: item["created_at"].(string),
-				UpdatedAt: item["updated_at"].(string),
-				Title:     item["title"].(string),
-				Message:   item["message"].(string),
-				ReadState: item["read_state"].(bool),
-				CourseID:  int64(item["course_id"].(float64)),
-				GroupID:   int64(groupID),
-				HTMLURL:   item["html_url"].(string),
-			}
-			stream.Collaborations = append(stream.Collaborations, c)
-
-		} else if item["type"] == "AssesmentRequest" {
-			a := AssesmentRequest{
-				ID:        int64(item["conference_id"].(float64)),
-				CreatedAt: item["created_at"].(string),
-				UpdatedAt: item["updated_at"].(string),
-				Title:     item["title"].(string),
-				Message:   item["message"].(string),
-				ReadState: item["read_state"].(bool),
-				CourseID:  int64(item["course_id"].(float64)),
-				GroupID:   int64(groupID),
-				HTMLURL:   item["html_url"].(string),
-			}
-			stream.AssesmentRequests = append(stream.AssesmentRequests, a)
-		}
+	for _, filter := range args.filter {
+		q.Add("filter[]", filter)
 	}
-	return &stream
-}
 
-// GetTodo returns todo list
-func (c *CanvasClient) GetTodo() (*[]Assignment, error) {
-	a := make([]Assignment, 0)
+	parsedURL.RawQuery = q.Encode()
 
-	requestURL := fmt.Sprintf("%s/api/v1/users/self/todo", c.ClientURL())
-	err := c.getJSON(requestURL, &a)
+	err = c.getPaginatedJSON(parsedURL.String(), &a)
 
 	if err != nil {
-		return &a, err
+		return a, err
 	}
 
-	return &a, nil
+	return a, nil
 }
@@ -5,6 +5,9 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
 )
 
 var client *CanvasClient
@@ -107,3 +110,46 @@ func testServer() (*http.Client, *http.ServeMux, *httptest.Server) {
 // 	assert.Equal(t, &expected, got)
 
 // }
+
+func TestCanvasClient_GetUpcomingEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/upcoming_events").
+		Reply(200).
+		BodyString(`[
+			{"id": 7, "type": "event", "title": "Office hours", "start_at": "2021-03-02T15:00:00Z"},
+			{"id": "assignment_20", "type": "assignment", "title": "Essay", "assignment": {"id": 20, "name": "Essay"}}
+		]`)
+
+	got, err := client.GetUpcomingEvents()
+	assert.Nil(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, int64(7), got[0].ID)
+	assert.Equal(t, "Office hours", got[0].Title)
+	assert.Equal(t, int64(20), got[1].ID)
+	assert.Equal(t, "Essay", got[1].Assignment.Name)
+}
+
+func TestCanvasClient_GetMissingSubmissions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(domain).
+		Get("/api/v1/users/self/missing_submissions").
+		MatchParam("include[]", "course").
+		MatchParam("filter[]", "submittable").
+		Reply(200).
+		BodyString(`[{"id": 20, "name": "Essay", "course": {"id": 5, "name": "Writing"}, "planner_override": null}]`)
+
+	got, err := client.GetMissingSubmissions(
+		WithMissingSubmissionsInclude("planner_overrides", "course"),
+		WithMissingSubmissionsFilter("submittable", "current_grading_period"),
+	)
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "Writing", got[0].Course.Name)
+	assert.Nil(t, got[0].PlannerOverride)
+
+	_, err = client.GetMissingSubmissions(WithMissingSubmissionsFilter("late"))
+	assert.Error(t, err)
+}